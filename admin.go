@@ -1,28 +1,194 @@
 package raftadmin
 
 import (
+	"bytes"
 	"context"
-	"crypto/sha1"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"math/rand"
+	"io"
+	"os"
+	"path"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/raft"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type admin struct {
-	r *raft.Raft
+	r Raft
+	// localAddr is the raft address of this node given with WithLocalAddress, if any. See localAddress.
+	localAddr raft.ServerAddress
+	logs      raft.LogStore
+	snapshots raft.SnapshotStore
+	stable    raft.StableStore
+
+	applyValidator     func([]byte) error
+	maxApplySize       int
+	rejectEmptyEntries bool
+
+	fsmChecksum func() (uint64, error)
+
+	// configIndex caches the lookups of configurationIndex.
+	configIndex configIndexCache
+
+	hostPortAddresses bool
+	idempotentAdds    bool
+	quorumChecks      bool
+
+	// futureTTL is how long futures are kept if nobody awaits or forgets them, or 0 to keep them forever.
+	futureTTL time.Duration
+	// numFutures is the number of futures this server holds. Use sync/atomic.
+	numFutures int32
+	maxFutures int
+	// draining is set by Drain and Close, after which no new futures are created. Use sync/atomic.
+	draining int32
+	// closed is closed by Close, which stops the background goroutines.
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	recoveryFile string
+	// confirmationSecret is set by WithConfirmation and signs the confirmation tokens of RemoveServer, RestoreSnapshot and Shutdown. It is nil if they don't need confirmation.
+	confirmationSecret []byte
+	confirmationTTL    time.Duration
+
+	// logRepairSecret is set by WithLogRepair and signs the confirmation tokens of DeleteLogRange. It is nil if DeleteLogRange is disabled.
+	logRepairSecret []byte
+
+	// extensions are the methods added with WithExtension, by name.
+	extensions map[string]Extension
+
+	// clusterID is the ID this server was added under with Clusters.Add, if any.
+	clusterID string
+
+	peerDialOptions []grpc.DialOption
+	peerMtx         sync.Mutex
+	peerConns       map[raft.ServerAddress]*grpc.ClientConn
+	// forwarded is set by WithLeaderForwarding.
+	forwarded *forwardedFutures
+
+	heartbeats  *heartbeatTracker
+	history     *statsHistory
+	maintenance maintenanceMode
+	metrics     *metrics
+	operations  *operationsLog
+
+	metadataMtx sync.Mutex
+	metadata    map[string]string
+
+	// These are applied to every call by interceptUnary and interceptStream.
+	logger           hclog.Logger
+	allowedMethods   map[string]bool
+	disabledMethods  map[string]bool
+	readOnly         bool
+	requireLeader    bool
+	rateLimits       []*rateLimit
+	defaultTimeout   time.Duration
+	methodTimeouts   map[string]time.Duration
+	tokenVerifier    func(ctx context.Context, token string) error
+	certificateRules []CertificateRule
+	policy           *policyFile
+	authorizer       func(ctx context.Context, method string, req proto.Message) error
+	hooks            []func(ctx context.Context, method string, req proto.Message) error
+	auditSinks       []func(AuditEvent)
+	tracer           trace.Tracer
+
+	// These are only used by ListenAndServe.
+	tlsConfig         *tls.Config
+	grpcServerOptions []grpc.ServerOption
+	serveContext      context.Context
+
+	// cachedLocalID is the ID of this node in the configuration, once localID found it.
+	localIDMtx    sync.Mutex
+	cachedLocalID string
+}
+
+// Server is a RaftAdmin server created by New.
+type Server struct {
+	a *admin
+}
+
+// New creates a RaftAdmin server for r, which is usually a *raft.Raft. Call Register on the result to serve it.
+func New(r Raft, opts ...Option) *Server {
+	a := newAdmin(r, opts...)
+	a.start()
+	return &Server{a}
+}
+
+// Register registers the RaftAdmin service on s, such that every call passes through the logger, authentication, authorizer, method restrictions, default timeout, hooks and operations log configured with options.
+func (s *Server) Register(gs grpc.ServiceRegistrar) {
+	gs.RegisterService(s.a.serviceDesc(), s.a)
+	if d := s.a.extensionServiceDesc(); d != nil {
+		gs.RegisterService(d, s.a)
+	}
+}
+
+// RegisterInspect registers the RaftInspect service on gs. It serves the methods of RaftAdmin that only inspect the cluster, through the same checks as Register.
+// Register it on a grpc.Server that is reachable more widely than the one with RaftAdmin, like for dashboards and exporters, or with more lenient interceptors.
+func (s *Server) RegisterInspect(gs grpc.ServiceRegistrar) {
+	gs.RegisterService(s.a.inspectServiceDesc(), s.a)
 }
 
-func Get(r *raft.Raft) pb.RaftAdminServer {
-	return &admin{r}
+// Collector returns the metrics of the server: the number of calls by method and status code, their latency, the number of pending and reaped futures and when the leader last changed.
+// Register it with your prometheus.Registerer. Calls are only counted if the server was registered with Register.
+func (s *Server) Collector() prometheus.Collector {
+	return s.a.metrics
 }
 
-func Register(s *grpc.Server, r *raft.Raft) {
-	pb.RegisterRaftAdminServer(s, Get(r))
+// Get returns the implementation of the RaftAdmin service, for when you want to register it yourself.
+// Calls don't pass through the logger, authentication, authorizer, method restrictions, default timeout, hooks and operations log; use New or Register for those, or for a Raft other than a *raft.Raft.
+// It doesn't watch raft in the background either, as it can't be closed: ServerHealth, PeerLastContact and WithQuorumChecks don't know which peers fail heartbeats, and WithFutureTTL and WithStatsHistory have no effect.
+func Get(r *raft.Raft, opts ...Option) pb.RaftAdminServer {
+	return newAdmin(r, opts...)
+}
+
+func newAdmin(r Raft, opts ...Option) *admin {
+	a := &admin{r: r, closed: make(chan struct{})}
+	for _, o := range opts {
+		o(a)
+	}
+	if a.clusterID != "" && a.logger != nil {
+		a.logger = a.logger.With("cluster", a.clusterID)
+	}
+	return a
+}
+
+// start starts watching raft and reaping futures in the background, until the Server is closed.
+func (a *admin) start() {
+	a.heartbeats = newHeartbeatTracker(a.r)
+	a.metrics = newMetrics(a)
+	if a.history != nil {
+		go a.history.run(a.r, a.closed)
+	}
+	if a.futureTTL > 0 {
+		go a.reapFutures()
+	}
+}
+
+// Register registers the RaftAdmin service on s. It is short for New(r, opts...).Register(s).
+func Register(s *grpc.Server, r Raft, opts ...Option) {
+	New(r, opts...).Register(s)
 }
 
 func timeout(ctx context.Context) time.Duration {
@@ -32,61 +198,259 @@ func timeout(ctx context.Context) time.Duration {
 	return 0
 }
 
+// requestTimeout returns the timeout to pass to raft: the one requested in milliseconds, or the time until the deadline of ctx, whichever is shorter.
+func requestTimeout(ctx context.Context, ms uint64) time.Duration {
+	t := timeout(ctx)
+	if r := time.Duration(ms) * time.Millisecond; r > 0 && (t <= 0 || r < t) {
+		return r
+	}
+	return t
+}
+
 var (
 	mtx        sync.Mutex
 	operations = map[string]*future{}
 )
 
 type future struct {
-	f   raft.Future
-	mtx sync.Mutex
+	// owner is the server that created the future, and counts it against WithMaxFutures.
+	owner *admin
+	f     raft.Future
+
+	// operation is the name of the RPC that created the future.
+	operation string
+	created   time.Time
+	// awaiting is the number of Await calls currently waiting for the future. Use sync/atomic.
+	awaiting int32
+	// done is closed by wait once the operation finished. After that, err and index hold its outcome, so any number of Await calls can return it.
+	done  chan struct{}
+	err   error
+	index uint64
+	// scope is who may Await and Forget the future, or empty for anyone. See futureScope.
+	scope string
+	// expires is when the future may be reaped, or zero if it never is. See WithFutureTTL.
+	expires time.Time
+	// timeout is how long wait waits for the operation, or 0 to wait forever. See WithMethodTimeout.
+	timeout time.Duration
+	// spanContext is the span of the call that created the future, if it was traced. See WithTracerProvider.
+	spanContext trace.SpanContext
+}
+
+// admitFutures reserves room for n raft operations under the limit of WithMaxFutures, before they are started. It fails if there's no room, or if the server is draining.
+// The caller must release them with releaseFutures once it no longer holds the futures, unless it hands them to toFuture, where Forget releases them.
+func (a *admin) admitFutures(n int) error {
+	if atomic.LoadInt32(&a.draining) != 0 {
+		return status.Error(codes.Unavailable, "the raftadmin server is shutting down and doesn't start new operations")
+	}
+	if held := atomic.AddInt32(&a.numFutures, int32(n)); a.maxFutures > 0 && int(held) > a.maxFutures {
+		atomic.AddInt32(&a.numFutures, -int32(n))
+		return status.Errorf(codes.ResourceExhausted, "the server already holds %d operations; await or forget them first", a.maxFutures)
+	}
+	return nil
 }
 
-func toFuture(f raft.Future) (*pb.Future, error) {
-	token := fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("%d", rand.Uint64()))))
+// releaseFutures releases what admitFutures reserved.
+func (a *admin) releaseFutures(n int) {
+	atomic.AddInt32(&a.numFutures, -int32(n))
+}
+
+// toFuture calls start to start a raft operation and remembers the future it returns for Await and Forget.
+// It fails without calling start if the server already holds the maximum number of futures set with WithMaxFutures.
+func (a *admin) toFuture(ctx context.Context, start func() raft.Future) (*pb.FutureResponse, error) {
+	if err := a.admitFutures(1); err != nil {
+		return nil, err
+	}
+	tokenBytes := make([]byte, 16)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		a.releaseFutures(1)
+		return nil, status.Errorf(codes.Internal, "failed to generate operation token: %v", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+	f := start()
+	method, _ := grpc.Method(ctx)
+	if a.logger != nil {
+		a.logger.Debug("raftadmin operation started", "operation", path.Base(method), "token", token, "caller", callerOf(ctx))
+	}
+	fut := &future{
+		owner:       a,
+		f:           f,
+		operation:   path.Base(method),
+		created:     time.Now(),
+		done:        make(chan struct{}),
+		scope:       futureScope(ctx),
+		spanContext: trace.SpanContextFromContext(ctx),
+		timeout:     a.methodTimeouts[path.Base(method)],
+	}
+	if a.futureTTL > 0 {
+		fut.expires = fut.created.Add(a.futureTTL)
+	}
 	mtx.Lock()
-	operations[token] = &future{f: f}
+	operations[token] = fut
 	mtx.Unlock()
-	return &pb.Future{
+	go fut.wait(token)
+	ret := &pb.FutureResponse{
 		OperationToken: token,
-	}, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(pb.AwaitMetadataKey); len(v) > 0 && v[0] == "true" {
+		var err error
+		if ret.Result, err = a.AwaitAndForget(ctx, ret.Future()); err != nil {
+			return nil, operationPendingError(err, token)
+		}
+	}
+	return ret, nil
+}
+
+// operationPendingError adds the token of the operation that is still running to err, which the caller got while waiting for it, so the client can Await or Forget it later.
+func operationPendingError(err error, token string) error {
+	st := status.Convert(err)
+	withToken, derr := status.New(st.Code(), st.Message()).WithDetails(&errdetails.ErrorInfo{
+		Reason: pb.OperationPendingReason,
+		Domain: pb.ErrorDomain,
+		Metadata: map[string]string{
+			"operation_token": token,
+		},
+	})
+	if derr != nil {
+		return err
+	}
+	return withToken.Err()
 }
 
 func (a *admin) Await(ctx context.Context, req *pb.Future) (*pb.AwaitResponse, error) {
 	mtx.Lock()
 	f, ok := operations[req.GetOperationToken()]
 	mtx.Unlock()
-	if !ok {
+	if !ok || f.owner != a || !f.visibleTo(ctx) {
 		return nil, fmt.Errorf("token %q unknown", req.GetOperationToken())
 	}
-	f.mtx.Lock()
-	err := f.f.Error()
-	f.mtx.Unlock()
-	if err != nil {
-		return &pb.AwaitResponse{
-			Error: err.Error(),
-		}, nil
+	span := a.followUpSpan(ctx, f, "Await")
+	defer span.End()
+	atomic.AddInt32(&f.awaiting, 1)
+	select {
+	case <-f.done:
+		atomic.AddInt32(&f.awaiting, -1)
+	case <-ctx.Done():
+		// The operation continues, and the client can Await it again.
+		atomic.AddInt32(&f.awaiting, -1)
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	// Record the outcome on the span of this call too, which is in the trace of whoever awaits.
+	rpcSpan := trace.SpanFromContext(ctx)
+	rpcSpan.SetAttributes(attribute.String("raft.operation", f.operation))
+	if f.err != nil {
+		span.SetStatus(otelcodes.Error, f.err.Error())
+		rpcSpan.SetAttributes(attribute.String("raft.error", f.err.Error()))
+		ret := &pb.AwaitResponse{
+			Error: f.err.Error(),
+		}
+		if errors.Is(f.err, raft.ErrNotLeader) {
+			addr, id := a.r.LeaderWithID()
+			ret.Leader = &pb.LeaderResponse{
+				Address: string(addr),
+				Id:      string(id),
+			}
+		}
+		return ret, nil
 	}
-	r := &pb.AwaitResponse{}
-	if ifx, ok := f.f.(raft.IndexFuture); ok {
-		r.Index = ifx.Index()
+	if _, ok := f.f.(raft.IndexFuture); ok {
+		span.SetAttributes(attribute.Int64("raft.index", int64(f.index)))
+		rpcSpan.SetAttributes(attribute.Int64("raft.index", int64(f.index)))
 	}
-	return r, nil
+	return &pb.AwaitResponse{
+		Index: f.index,
+	}, nil
 }
 
 func (a *admin) Forget(ctx context.Context, req *pb.Future) (*pb.ForgetResponse, error) {
 	mtx.Lock()
-	delete(operations, req.GetOperationToken())
+	f, ok := operations[req.GetOperationToken()]
+	ok = ok && f.owner == a && f.visibleTo(ctx)
+	if ok {
+		forgetLocked(req.GetOperationToken(), f)
+	}
 	mtx.Unlock()
+	if ok {
+		a.followUpSpan(ctx, f, "Forget").End()
+		if a.logger != nil {
+			a.logger.Debug("raftadmin operation forgotten", "operation", f.operation, "token", req.GetOperationToken())
+		}
+	}
 	return &pb.ForgetResponse{}, nil
 }
 
-func (a *admin) AddNonvoter(ctx context.Context, req *pb.AddNonvoterRequest) (*pb.Future, error) {
-	return toFuture(a.r.AddNonvoter(raft.ServerID(req.GetId()), raft.ServerAddress(req.GetAddress()), req.GetPreviousIndex(), timeout(ctx)))
+func (a *admin) AwaitAndForget(ctx context.Context, req *pb.Future) (*pb.AwaitResponse, error) {
+	resp, err := a.Await(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		// The client gave up, and might want to Await again later.
+		return nil, status.FromContextError(err).Err()
+	}
+	if _, err := a.Forget(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *admin) ListPendingFutures(ctx context.Context, req *pb.ListPendingFuturesRequest) (*pb.ListPendingFuturesResponse, error) {
+	mtx.Lock()
+	defer mtx.Unlock()
+	ret := &pb.ListPendingFuturesResponse{}
+	for token, f := range operations {
+		if f.owner != a {
+			// It belongs to another cluster served by this process.
+			continue
+		}
+		if !f.visibleTo(ctx) {
+			// Don't hand out the tokens of other callers.
+			token = ""
+		}
+		pf := &pb.ListPendingFuturesResponse_PendingFuture{
+			OperationToken:  token,
+			Operation:       f.operation,
+			CreatedUnixNano: f.created.UnixNano(),
+			Awaiting:        int64(atomic.LoadInt32(&f.awaiting)),
+		}
+		select {
+		case <-f.done:
+			pf.Completed = true
+		default:
+		}
+		ret.Futures = append(ret.Futures, pf)
+	}
+	sort.Slice(ret.Futures, func(i, j int) bool {
+		return ret.Futures[i].GetCreatedUnixNano() < ret.Futures[j].GetCreatedUnixNano()
+	})
+	return ret, nil
+}
+
+func (a *admin) AddNonvoter(ctx context.Context, req *pb.AddNonvoterRequest) (*pb.FutureResponse, error) {
+	return a.toFuture(ctx, func() raft.Future {
+		if f, ok := a.alreadyPresent(req.GetId(), req.GetAddress(), raft.Nonvoter); ok {
+			return f
+		}
+		return a.r.AddNonvoter(raft.ServerID(req.GetId()), raft.ServerAddress(req.GetAddress()), req.GetPreviousIndex(), requestTimeout(ctx, req.GetTimeoutMs()))
+	})
 }
 
-func (a *admin) AddVoter(ctx context.Context, req *pb.AddVoterRequest) (*pb.Future, error) {
-	return toFuture(a.r.AddVoter(raft.ServerID(req.GetId()), raft.ServerAddress(req.GetAddress()), req.GetPreviousIndex(), timeout(ctx)))
+func (a *admin) AddVoter(ctx context.Context, req *pb.AddVoterRequest) (*pb.FutureResponse, error) {
+	warnings, err := a.checkQuorum("AddVoter", raft.ServerID(req.GetId()), true, req.GetForce())
+	if err != nil {
+		return nil, err
+	}
+	ret, err := a.toFuture(ctx, func() raft.Future {
+		if f, ok := a.alreadyPresent(req.GetId(), req.GetAddress(), raft.Voter); ok {
+			return f
+		}
+		return a.r.AddVoter(raft.ServerID(req.GetId()), raft.ServerAddress(req.GetAddress()), req.GetPreviousIndex(), requestTimeout(ctx, req.GetTimeoutMs()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret.Warnings = warnings
+	return ret, nil
 }
 
 func (a *admin) AppliedIndex(ctx context.Context, req *pb.AppliedIndexRequest) (*pb.AppliedIndexResponse, error) {
@@ -95,44 +459,529 @@ func (a *admin) AppliedIndex(ctx context.Context, req *pb.AppliedIndexRequest) (
 	}, nil
 }
 
-func (a *admin) ApplyLog(ctx context.Context, req *pb.ApplyLogRequest) (*pb.Future, error) {
-	return toFuture(a.r.ApplyLog(raft.Log{Data: req.GetData(), Extensions: req.GetExtensions()}, timeout(ctx)))
+// ApplyBatch applies all entries without waiting in between, so raft can group them into as few disk writes and AppendEntries calls as possible, and then waits for all of them.
+func (a *admin) ApplyBatch(ctx context.Context, req *pb.ApplyBatchRequest) (*pb.ApplyBatchResponse, error) {
+	if err := a.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	// The futures are held until all entries are applied, so they count against WithMaxFutures until then.
+	if err := a.admitFutures(len(req.GetEntries())); err != nil {
+		return nil, err
+	}
+	defer a.releaseFutures(len(req.GetEntries()))
+	pending := make([]pendingEntry, len(req.GetEntries()))
+	for i, e := range req.GetEntries() {
+		pending[i] = a.startApply(ctx, e)
+	}
+	ret := &pb.ApplyBatchResponse{
+		Results: make([]*pb.AwaitResponse, len(pending)),
+	}
+	for i, p := range pending {
+		ret.Results[i] = p.result()
+	}
+	return ret, nil
+}
+
+// pendingEntry is an entry handed to raft by ApplyBatch or ApplyStream, or the reason it was rejected.
+type pendingEntry struct {
+	f   raft.ApplyFuture
+	err error
+	// admitted is the server whose admitFutures counted f, if it must be released once the result is sent.
+	admitted *admin
 }
 
-func (a *admin) Barrier(ctx context.Context, req *pb.BarrierRequest) (*pb.Future, error) {
-	return toFuture(a.r.Barrier(timeout(ctx)))
+// release releases the room reserved for the entry with admitFutures, if any.
+func (p pendingEntry) release() {
+	if p.admitted != nil {
+		p.admitted.releaseFutures(1)
+	}
 }
 
-func (a *admin) DemoteVoter(ctx context.Context, req *pb.DemoteVoterRequest) (*pb.Future, error) {
-	return toFuture(a.r.DemoteVoter(raft.ServerID(req.GetId()), req.GetPreviousIndex(), timeout(ctx)))
+// startApply validates the entry and hands it to raft without waiting for it.
+func (a *admin) startApply(ctx context.Context, req *pb.ApplyLogRequest) pendingEntry {
+	if err := a.validateEntry(req); err != nil {
+		return pendingEntry{err: err}
+	}
+	return pendingEntry{f: a.r.ApplyLog(raft.Log{Data: req.GetData(), Extensions: req.GetExtensions()}, requestTimeout(ctx, req.GetTimeoutMs()))}
+}
+
+// result waits for the entry to be applied.
+func (p pendingEntry) result() *pb.AwaitResponse {
+	if p.err != nil {
+		return &pb.AwaitResponse{Error: status.Convert(p.err).Message()}
+	}
+	if err := p.f.Error(); err != nil {
+		return &pb.AwaitResponse{Error: err.Error()}
+	}
+	return &pb.AwaitResponse{Index: p.f.Index()}
+}
+
+func (a *admin) ApplyLog(ctx context.Context, req *pb.ApplyLogRequest) (*pb.FutureResponse, error) {
+	if err := a.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.validateEntry(req); err != nil {
+		return nil, err
+	}
+	return a.toFuture(ctx, func() raft.Future {
+		return a.r.ApplyLog(raft.Log{Data: req.GetData(), Extensions: req.GetExtensions()}, requestTimeout(ctx, req.GetTimeoutMs()))
+	})
+}
+
+// validateEntry checks an entry against the limits set with WithMaxApplySize, WithRejectEmptyEntries and WithApplyValidator before it is appended to the log.
+func (a *admin) validateEntry(req *pb.ApplyLogRequest) error {
+	if a.rejectEmptyEntries && len(req.GetData()) == 0 {
+		return status.Error(codes.InvalidArgument, "entry is empty")
+	}
+	if size := len(req.GetData()) + len(req.GetExtensions()); a.maxApplySize > 0 && size > a.maxApplySize {
+		return status.Errorf(codes.InvalidArgument, "entry is %d bytes, which exceeds the maximum of %d bytes", size, a.maxApplySize)
+	}
+	if a.applyValidator != nil {
+		if err := a.applyValidator(req.GetData()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "entry rejected: %v", err)
+		}
+	}
+	return nil
+}
+
+// maxApplyStreamInFlight is the maximum number of entries ApplyStream has handed to raft without having sent their result.
+const maxApplyStreamInFlight = 1024
+
+func (a *admin) ApplyStream(stream pb.RaftAdmin_ApplyStreamServer) error {
+	if err := a.checkMaintenance(stream.Context()); err != nil {
+		return err
+	}
+	// The capacity of futures bounds the number of entries in flight: once it's full we stop reading from the client.
+	futures := make(chan pendingEntry, maxApplyStreamInFlight)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(futures)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					recvErr <- err
+				}
+				return
+			}
+			// Entries in flight count against WithMaxFutures like other operations.
+			if err := a.admitFutures(1); err != nil {
+				futures <- pendingEntry{err: err}
+				continue
+			}
+			p := a.startApply(stream.Context(), msg)
+			p.admitted = a
+			futures <- p
+		}
+	}()
+	for p := range futures {
+		res := p.result()
+		p.release()
+		if err := stream.Send(res); err != nil {
+			// Unblock the receiver, which will stop once it notices the stream is broken.
+			go func() {
+				for p := range futures {
+					p.release()
+				}
+			}()
+			return err
+		}
+	}
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+func (a *admin) Barrier(ctx context.Context, req *pb.BarrierRequest) (*pb.FutureResponse, error) {
+	return a.toFuture(ctx, func() raft.Future { return a.r.Barrier(requestTimeout(ctx, req.GetTimeoutMs())) })
+}
+
+func (a *admin) BootstrapCluster(ctx context.Context, req *pb.BootstrapClusterRequest) (*pb.FutureResponse, error) {
+	if len(req.GetServers()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one server is required")
+	}
+	c, err := fromServers(req.GetServers())
+	if err != nil {
+		return nil, err
+	}
+	return a.toFuture(ctx, func() raft.Future { return a.r.BootstrapCluster(c) })
+}
+
+func (a *admin) ClusterInfo(ctx context.Context, req *pb.ClusterInfoRequest) (*pb.ClusterInfoResponse, error) {
+	// Everything is derived from a single call to Stats where possible, so the values match up.
+	stats := a.r.Stats()
+	ret := &pb.ClusterInfoResponse{
+		Address: a.localAddress(),
+	}
+	var err error
+	if ret.State, err = toState(a.r.State()); err != nil {
+		return nil, err
+	}
+	if ret.Term, err = strconv.ParseUint(stats["term"], 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to parse term: %v", err)
+	}
+	addr, id := a.r.LeaderWithID()
+	ret.Leader = &pb.LeaderResponse{
+		Address: string(addr),
+		Id:      string(id),
+	}
+	if ret.Configuration, err = a.configuration(); err != nil {
+		return nil, err
+	}
+	a.fillMetadata(ctx, ret.Configuration.GetServers())
+	for _, s := range ret.Configuration.GetServers() {
+		if s.GetAddress() == ret.Address {
+			ret.Id = s.GetId()
+		}
+	}
+	if ret.Indexes, err = a.indexes(stats); err != nil {
+		return nil, err
+	}
+	if ret.LastSnapshot, err = a.lastSnapshot(stats); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// localAddress returns the raft address of this node: the one given with WithLocalAddress, or else the one a *raft.Raft includes in String(), as it doesn't expose it directly. It returns "" for other Raft implementations without WithLocalAddress.
+func (a *admin) localAddress() string {
+	if a.localAddr != "" {
+		return string(a.localAddr)
+	}
+	r, ok := a.r.(*raft.Raft)
+	if !ok {
+		return ""
+	}
+	s := strings.TrimPrefix(r.String(), "Node at ")
+	if i := strings.LastIndex(s, " ["); i >= 0 {
+		s = s[:i]
+	}
+	return s
+}
+
+func (a *admin) DemoteVoter(ctx context.Context, req *pb.DemoteVoterRequest) (*pb.FutureResponse, error) {
+	warnings, err := a.checkQuorum("DemoteVoter", raft.ServerID(req.GetId()), false, req.GetForce())
+	if err != nil {
+		return nil, err
+	}
+	ret, err := a.toFuture(ctx, func() raft.Future {
+		return a.r.DemoteVoter(raft.ServerID(req.GetId()), req.GetPreviousIndex(), requestTimeout(ctx, req.GetTimeoutMs()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret.Warnings = warnings
+	return ret, nil
+}
+
+func (a *admin) DownloadSnapshot(req *pb.DownloadSnapshotRequest, stream pb.RaftAdmin_DownloadSnapshotServer) error {
+	if a.snapshots == nil {
+		return status.Error(codes.FailedPrecondition, "DownloadSnapshot requires the SnapshotStore to be passed with raftadmin.WithSnapshotStore")
+	}
+	id := req.GetId()
+	if id == "" {
+		snapshots, err := a.snapshots.List()
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return status.Error(codes.NotFound, "there are no snapshots")
+		}
+		id = snapshots[0].ID
+	}
+	meta, rc, err := a.snapshots.Open(id)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	pm, err := toSnapshotMeta(meta)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.DownloadSnapshotResponse{Message: &pb.DownloadSnapshotResponse_Meta{Meta: pm}}); err != nil {
+		return err
+	}
+	h := sha256.New()
+	buf := make([]byte, 256*1024)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			h.Write(buf[:n])
+			if err := stream.Send(&pb.DownloadSnapshotResponse{Message: &pb.DownloadSnapshotResponse_Data{Data: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return stream.Send(&pb.DownloadSnapshotResponse{Message: &pb.DownloadSnapshotResponse_Sha256{Sha256: h.Sum(nil)}})
+}
+
+func toSnapshotMeta(m *raft.SnapshotMeta) (*pb.SnapshotMeta, error) {
+	servers, err := toServers(m.Configuration)
+	if err != nil {
+		return nil, err
+	}
+	ret := &pb.SnapshotMeta{
+		Id:                 m.ID,
+		Index:              m.Index,
+		Term:               m.Term,
+		Size:               m.Size,
+		Version:            int64(m.Version),
+		Configuration:      servers,
+		ConfigurationIndex: m.ConfigurationIndex,
+	}
+	// FileSnapshotStore and InmemSnapshotStore use term-index-unixmillis as the ID.
+	var term, index uint64
+	var msec int64
+	if n, _ := fmt.Sscanf(m.ID, "%d-%d-%d", &term, &index, &msec); n == 3 && term == m.Term && index == m.Index {
+		ret.CreatedUnixNano = msec * int64(time.Millisecond)
+	}
+	return ret, nil
 }
 
 func (a *admin) GetConfiguration(ctx context.Context, req *pb.GetConfigurationRequest) (*pb.GetConfigurationResponse, error) {
+	ret, err := a.configuration()
+	if err != nil {
+		return nil, err
+	}
+	a.fillMetadata(ctx, ret.GetServers())
+	return ret, nil
+}
+
+// configuration returns the latest configuration with its index.
+func (a *admin) configuration() (*pb.GetConfigurationResponse, error) {
 	f := a.r.GetConfiguration()
 	if err := f.Error(); err != nil {
 		return nil, err
 	}
-	resp := &pb.GetConfigurationResponse{}
-	for _, s := range f.Configuration().Servers {
+	servers, err := toServers(f.Configuration())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetConfigurationResponse{
+		Servers: servers,
+		Index:   a.configurationIndex(f.Index()),
+	}, nil
+}
+
+func toServers(c raft.Configuration) ([]*pb.GetConfigurationResponse_Server, error) {
+	var ret []*pb.GetConfigurationResponse_Server
+	for _, s := range c.Servers {
 		cs := &pb.GetConfigurationResponse_Server{
 			Id:      string(s.ID),
 			Address: string(s.Address),
 		}
-		switch s.Suffrage {
-		case raft.Voter:
-			cs.Suffrage = pb.GetConfigurationResponse_Server_VOTER
-		case raft.Nonvoter:
-			cs.Suffrage = pb.GetConfigurationResponse_Server_NONVOTER
-		case raft.Staging:
-			cs.Suffrage = pb.GetConfigurationResponse_Server_STAGING
+		var err error
+		cs.Suffrage, err = toSuffrage(s)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, cs)
+	}
+	return ret, nil
+}
+
+func fromServers(servers []*pb.GetConfigurationResponse_Server) (raft.Configuration, error) {
+	var ret raft.Configuration
+	for _, s := range servers {
+		rs := raft.Server{
+			ID:      raft.ServerID(s.GetId()),
+			Address: raft.ServerAddress(s.GetAddress()),
+		}
+		switch s.GetSuffrage() {
+		case pb.GetConfigurationResponse_Server_VOTER:
+			rs.Suffrage = raft.Voter
+		case pb.GetConfigurationResponse_Server_NONVOTER:
+			rs.Suffrage = raft.Nonvoter
+		case pb.GetConfigurationResponse_Server_STAGING:
+			rs.Suffrage = raft.Staging
 		default:
-			return nil, fmt.Errorf("unknown server suffrage %v for server %q", s.Suffrage, s.ID)
+			return raft.Configuration{}, status.Errorf(codes.InvalidArgument, "unknown server suffrage %v for server %q", s.GetSuffrage(), s.GetId())
+		}
+		ret.Servers = append(ret.Servers, rs)
+	}
+	return ret, nil
+}
+
+func toSuffrage(s raft.Server) (pb.GetConfigurationResponse_Server_Suffrage, error) {
+	switch s.Suffrage {
+	case raft.Voter:
+		return pb.GetConfigurationResponse_Server_VOTER, nil
+	case raft.Nonvoter:
+		return pb.GetConfigurationResponse_Server_NONVOTER, nil
+	case raft.Staging:
+		return pb.GetConfigurationResponse_Server_STAGING, nil
+	default:
+		return 0, fmt.Errorf("unknown server suffrage %v for server %q", s.Suffrage, s.ID)
+	}
+}
+
+func (a *admin) GetIndexes(ctx context.Context, req *pb.GetIndexesRequest) (*pb.GetIndexesResponse, error) {
+	// raft.Stats reads all indexes in one go, so they are much less likely to be skewed than when fetched one by one.
+	return a.indexes(a.r.Stats())
+}
+
+// indexes builds a GetIndexesResponse from the output of raft.Stats.
+func (a *admin) indexes(stats map[string]string) (*pb.GetIndexesResponse, error) {
+	ret := &pb.GetIndexesResponse{}
+	for k, f := range map[string]*uint64{
+		"last_log_index":      &ret.LastIndex,
+		"last_log_term":       &ret.LastTerm,
+		"commit_index":        &ret.CommitIndex,
+		"applied_index":       &ret.AppliedIndex,
+		"last_snapshot_index": &ret.LastSnapshotIndex,
+		"last_snapshot_term":  &ret.LastSnapshotTerm,
+	} {
+		var err error
+		if *f, err = strconv.ParseUint(stats[k], 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", k, err)
+		}
+	}
+	if a.logs != nil {
+		var err error
+		if ret.FirstIndex, err = a.logs.FirstIndex(); err != nil {
+			return nil, err
 		}
-		resp.Servers = append(resp.Servers, cs)
+	}
+	return ret, nil
+}
+
+const (
+	// maxLogsPerResponse is the maximum number of entries GetLogs returns at once.
+	maxLogsPerResponse = 1000
+	// maxLogsResponseSize is the amount of data after which GetLogs stops adding entries, to stay well below gRPC's default 4MB message limit.
+	maxLogsResponseSize = 2 * 1024 * 1024
+)
+
+func (a *admin) GetLogs(ctx context.Context, req *pb.GetLogsRequest) (*pb.GetLogsResponse, error) {
+	if a.logs == nil {
+		return nil, status.Error(codes.FailedPrecondition, "GetLogs requires the LogStore to be passed with raftadmin.WithLogStore")
+	}
+	first, err := a.logs.FirstIndex()
+	if err != nil {
+		return nil, err
+	}
+	last, err := a.logs.LastIndex()
+	if err != nil {
+		return nil, err
+	}
+	start := req.GetStartIndex()
+	if start < first {
+		start = first
+	}
+	end := req.GetEndIndex()
+	if end == 0 || end > last {
+		end = last
+	}
+	resp := &pb.GetLogsResponse{}
+	if last == 0 {
+		// The log is empty.
+		return resp, nil
+	}
+	limit := req.GetMaxEntries()
+	if limit == 0 || limit > maxLogsPerResponse {
+		limit = maxLogsPerResponse
+	}
+	var size int
+	for i := start; end >= i; i++ {
+		if uint64(len(resp.Logs)) >= limit || size >= maxLogsResponseSize {
+			resp.NextIndex = i
+			break
+		}
+		var l raft.Log
+		if err := a.logs.GetLog(i, &l); err != nil {
+			if err == raft.ErrLogNotFound {
+				// Compacted away while we were reading.
+				continue
+			}
+			return nil, err
+		}
+		pl := &pb.GetLogsResponse_Log{
+			Index:      l.Index,
+			Term:       l.Term,
+			Type:       pb.GetLogsResponse_Log_Type(l.Type),
+			DataLength: uint64(len(l.Data)),
+		}
+		if !l.AppendedAt.IsZero() {
+			pl.AppendedAtUnixNano = l.AppendedAt.UnixNano()
+		}
+		if req.GetIncludeData() {
+			pl.Data = l.Data
+			pl.Extensions = l.Extensions
+			size += len(l.Data) + len(l.Extensions)
+		}
+		resp.Logs = append(resp.Logs, pl)
 	}
 	return resp, nil
 }
 
+func (a *admin) GetReloadableConfig(ctx context.Context, req *pb.GetReloadableConfigRequest) (*pb.GetReloadableConfigResponse, error) {
+	return &pb.GetReloadableConfigResponse{
+		Config: toReloadableConfig(a.r.ReloadableConfig()),
+	}, nil
+}
+
+func (a *admin) GetStableStore(ctx context.Context, req *pb.GetStableStoreRequest) (*pb.GetStableStoreResponse, error) {
+	if a.stable == nil {
+		return nil, status.Error(codes.FailedPrecondition, "GetStableStore requires the StableStore to be passed with raftadmin.WithStableStore")
+	}
+	ret := &pb.GetStableStoreResponse{}
+	var err error
+	// Like raft itself, treat "not found" as unset.
+	if ret.CurrentTerm, err = a.stable.GetUint64([]byte("CurrentTerm")); err != nil && err.Error() != "not found" {
+		return nil, err
+	}
+	if ret.LastVoteTerm, err = a.stable.GetUint64([]byte("LastVoteTerm")); err != nil && err.Error() != "not found" {
+		return nil, err
+	}
+	cand, err := a.stable.Get([]byte("LastVoteCand"))
+	if err != nil && err.Error() != "not found" {
+		return nil, err
+	}
+	ret.LastVoteCandidate = string(cand)
+	return ret, nil
+}
+
+func (a *admin) GracefulShutdown(ctx context.Context, req *pb.GracefulShutdownRequest) (*pb.FutureResponse, error) {
+	if err := a.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	if a.r.State() == raft.Leader {
+		if err := a.drainLeadership(ctx, time.Duration(req.GetDrainTimeoutMs())*time.Millisecond); err != nil && !req.GetForce() {
+			return nil, status.Errorf(codes.FailedPrecondition, "not shutting down because the leadership transfer failed (use force to shut down anyway): %v", err)
+		}
+	}
+	return a.toFuture(ctx, func() raft.Future { return a.r.Shutdown() })
+}
+
+// drainLeadership transfers leadership to another node and waits for it, for at most timeout if it isn't 0.
+func (a *admin) drainLeadership(ctx context.Context, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	f := a.r.LeadershipTransfer()
+	done := make(chan error, 1)
+	go func() {
+		done <- f.Error()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (a *admin) LastContact(ctx context.Context, req *pb.LastContactRequest) (*pb.LastContactResponse, error) {
 	t := a.r.LastContact()
 	return &pb.LastContactResponse{
@@ -146,44 +995,473 @@ func (a *admin) LastIndex(ctx context.Context, req *pb.LastIndexRequest) (*pb.La
 	}, nil
 }
 
+func (a *admin) LastSnapshot(ctx context.Context, req *pb.LastSnapshotRequest) (*pb.LastSnapshotResponse, error) {
+	return a.lastSnapshot(a.r.Stats())
+}
+
+// lastSnapshot builds a LastSnapshotResponse from the output of raft.Stats and the SnapshotStore.
+func (a *admin) lastSnapshot(stats map[string]string) (*pb.LastSnapshotResponse, error) {
+	ret := &pb.LastSnapshotResponse{}
+	var lastIndex uint64
+	for k, f := range map[string]*uint64{
+		"last_snapshot_index": &ret.Index,
+		"last_snapshot_term":  &ret.Term,
+		"last_log_index":      &lastIndex,
+	} {
+		var err error
+		if *f, err = strconv.ParseUint(stats[k], 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", k, err)
+		}
+	}
+	if lastIndex > ret.Index {
+		ret.EntriesSince = lastIndex - ret.Index
+	}
+	if a.snapshots != nil {
+		snapshots, err := a.snapshots.List()
+		if err != nil {
+			return nil, err
+		}
+		if len(snapshots) > 0 {
+			if ret.Meta, err = toSnapshotMeta(snapshots[0]); err != nil {
+				return nil, err
+			}
+			if c := ret.Meta.GetCreatedUnixNano(); c != 0 {
+				ret.AgeNanos = int64(time.Since(time.Unix(0, c)))
+			}
+		}
+	}
+	return ret, nil
+}
+
 func (a *admin) Leader(ctx context.Context, req *pb.LeaderRequest) (*pb.LeaderResponse, error) {
+	addr, id := a.r.LeaderWithID()
 	return &pb.LeaderResponse{
-		Address: string(a.r.Leader()),
+		Address: string(addr),
+		Id:      string(id),
 	}, nil
 }
 
-func (a *admin) LeadershipTransfer(ctx context.Context, req *pb.LeadershipTransferRequest) (*pb.Future, error) {
-	return toFuture(a.r.LeadershipTransfer())
+func (a *admin) LeadershipTransfer(ctx context.Context, req *pb.LeadershipTransferRequest) (*pb.FutureResponse, error) {
+	return a.toFuture(ctx, func() raft.Future { return a.r.LeadershipTransfer() })
+}
+
+func (a *admin) LeadershipTransferToServer(ctx context.Context, req *pb.LeadershipTransferToServerRequest) (*pb.FutureResponse, error) {
+	return a.toFuture(ctx, func() raft.Future {
+		return a.r.LeadershipTransferToServer(raft.ServerID(req.GetId()), raft.ServerAddress(req.GetAddress()))
+	})
+}
+
+func (a *admin) ListSnapshots(ctx context.Context, req *pb.ListSnapshotsRequest) (*pb.ListSnapshotsResponse, error) {
+	if a.snapshots == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ListSnapshots requires the SnapshotStore to be passed with raftadmin.WithSnapshotStore")
+	}
+	snapshots, err := a.snapshots.List()
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListSnapshotsResponse{}
+	for _, m := range snapshots {
+		pm, err := toSnapshotMeta(m)
+		if err != nil {
+			return nil, err
+		}
+		resp.Snapshots = append(resp.Snapshots, pm)
+	}
+	return resp, nil
+}
+
+func (a *admin) Observe(req *pb.ObserveRequest, stream pb.RaftAdmin_ObserveServer) error {
+	want := map[pb.ObserveRequest_Type]bool{}
+	for _, t := range req.GetTypes() {
+		want[t] = true
+	}
+	ch := make(chan raft.Observation, 64)
+	o := raft.NewObserver(ch, false, func(o *raft.Observation) bool {
+		t, ok := observationType(o.Data)
+		return ok && (len(want) == 0 || want[t])
+	})
+	a.r.RegisterObserver(o)
+	defer a.r.DeregisterObserver(o)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case o := <-ch:
+			msg, err := toObservation(o)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
 }
 
-func (a *admin) LeadershipTransferToServer(ctx context.Context, req *pb.LeadershipTransferToServerRequest) (*pb.Future, error) {
-	return toFuture(a.r.LeadershipTransferToServer(raft.ServerID(req.GetId()), raft.ServerAddress(req.GetAddress())))
+// observationType returns the type of a raft observation, or false if Observe doesn't support it.
+func observationType(d interface{}) (pb.ObserveRequest_Type, bool) {
+	switch d.(type) {
+	case raft.LeaderObservation:
+		return pb.ObserveRequest_LEADER, true
+	case raft.PeerObservation:
+		return pb.ObserveRequest_PEER, true
+	case raft.FailedHeartbeatObservation:
+		return pb.ObserveRequest_FAILED_HEARTBEAT, true
+	case raft.ResumedHeartbeatObservation:
+		return pb.ObserveRequest_RESUMED_HEARTBEAT, true
+	case raft.RequestVoteRequest:
+		return pb.ObserveRequest_REQUEST_VOTE, true
+	case raft.RaftState:
+		return pb.ObserveRequest_STATE, true
+	default:
+		return 0, false
+	}
 }
 
-func (a *admin) RemoveServer(ctx context.Context, req *pb.RemoveServerRequest) (*pb.Future, error) {
-	return toFuture(a.r.RemoveServer(raft.ServerID(req.GetId()), req.GetPreviousIndex(), timeout(ctx)))
+func toObservation(o raft.Observation) (*pb.Observation, error) {
+	ret := &pb.Observation{
+		UnixNano: time.Now().UnixNano(),
+	}
+	switch d := o.Data.(type) {
+	case raft.LeaderObservation:
+		ret.Observation = &pb.Observation_Leader_{
+			Leader: &pb.Observation_Leader{
+				Address: string(d.LeaderAddr),
+				Id:      string(d.LeaderID),
+			},
+		}
+	case raft.PeerObservation:
+		suffrage, err := toSuffrage(d.Peer)
+		if err != nil {
+			return nil, err
+		}
+		ret.Observation = &pb.Observation_Peer_{
+			Peer: &pb.Observation_Peer{
+				Removed:  d.Removed,
+				Id:       string(d.Peer.ID),
+				Address:  string(d.Peer.Address),
+				Suffrage: suffrage,
+			},
+		}
+	case raft.FailedHeartbeatObservation:
+		ret.Observation = &pb.Observation_FailedHeartbeat_{
+			FailedHeartbeat: &pb.Observation_FailedHeartbeat{
+				Id:                  string(d.PeerID),
+				LastContactUnixNano: d.LastContact.UnixNano(),
+			},
+		}
+	case raft.ResumedHeartbeatObservation:
+		ret.Observation = &pb.Observation_ResumedHeartbeat_{
+			ResumedHeartbeat: &pb.Observation_ResumedHeartbeat{
+				Id: string(d.PeerID),
+			},
+		}
+	case raft.RequestVoteRequest:
+		addr := d.RPCHeader.Addr
+		if len(addr) == 0 {
+			// Older protocol versions only set the deprecated field.
+			addr = d.Candidate
+		}
+		ret.Observation = &pb.Observation_RequestVote_{
+			RequestVote: &pb.Observation_RequestVote{
+				CandidateId:        string(d.RPCHeader.ID),
+				CandidateAddress:   string(addr),
+				Term:               d.Term,
+				LastLogIndex:       d.LastLogIndex,
+				LastLogTerm:        d.LastLogTerm,
+				LeadershipTransfer: d.LeadershipTransfer,
+			},
+		}
+	case raft.RaftState:
+		s, err := toState(d)
+		if err != nil {
+			return nil, err
+		}
+		ret.Observation = &pb.Observation_State{
+			State: s,
+		}
+	default:
+		return nil, fmt.Errorf("unexpected observation %T", o.Data)
+	}
+	return ret, nil
 }
 
-func (a *admin) Shutdown(ctx context.Context, req *pb.ShutdownRequest) (*pb.Future, error) {
-	return toFuture(a.r.Shutdown())
+func (a *admin) ReloadConfig(ctx context.Context, req *pb.ReloadConfigRequest) (*pb.ReloadConfigResponse, error) {
+	rc := a.r.ReloadableConfig()
+	c := req.GetConfig()
+	if c.GetTrailingLogs() != 0 {
+		rc.TrailingLogs = c.GetTrailingLogs()
+	}
+	if c.GetSnapshotIntervalNanos() != 0 {
+		rc.SnapshotInterval = time.Duration(c.GetSnapshotIntervalNanos())
+	}
+	if c.GetSnapshotThreshold() != 0 {
+		rc.SnapshotThreshold = c.GetSnapshotThreshold()
+	}
+	if c.GetHeartbeatTimeoutNanos() != 0 {
+		rc.HeartbeatTimeout = time.Duration(c.GetHeartbeatTimeoutNanos())
+	}
+	if c.GetElectionTimeoutNanos() != 0 {
+		rc.ElectionTimeout = time.Duration(c.GetElectionTimeoutNanos())
+	}
+	if err := a.r.ReloadConfig(rc); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.ReloadConfigResponse{
+		Config: toReloadableConfig(a.r.ReloadableConfig()),
+	}, nil
 }
 
-func (a *admin) Snapshot(ctx context.Context, req *pb.SnapshotRequest) (*pb.Future, error) {
-	return toFuture(a.r.Snapshot())
+func toReloadableConfig(rc raft.ReloadableConfig) *pb.ReloadableConfig {
+	return &pb.ReloadableConfig{
+		TrailingLogs:          rc.TrailingLogs,
+		SnapshotIntervalNanos: int64(rc.SnapshotInterval),
+		SnapshotThreshold:     rc.SnapshotThreshold,
+		HeartbeatTimeoutNanos: int64(rc.HeartbeatTimeout),
+		ElectionTimeoutNanos:  int64(rc.ElectionTimeout),
+	}
+}
+
+func (a *admin) RemoveServer(ctx context.Context, req *pb.RemoveServerRequest) (*pb.FutureResponse, error) {
+	if err := a.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	id := raft.ServerID(req.GetId())
+	if req.GetAddress() != "" {
+		if id != "" {
+			return nil, status.Error(codes.InvalidArgument, "only one of id and address can be set")
+		}
+		var err error
+		id, err = a.idForAddress(raft.ServerAddress(req.GetAddress()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, leader := a.r.LeaderWithID(); id == leader && id != "" && !req.GetForce() {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is the leader; transfer leadership to another server first with LeadershipTransfer, or set force to remove it anyway", id)
+	}
+	warnings, err := a.checkQuorum("RemoveServer", id, false, req.GetForce())
+	if err != nil {
+		return nil, err
+	}
+	if a.confirmationSecret != nil {
+		effect, err := a.removeServerEffect(id)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.checkConfirmation("RemoveServer", effect, req.GetConfirmationToken()); err != nil {
+			return nil, err
+		}
+	}
+	ret, err := a.toFuture(ctx, func() raft.Future {
+		return a.r.RemoveServer(id, req.GetPreviousIndex(), requestTimeout(ctx, req.GetTimeoutMs()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret.Warnings = warnings
+	return ret, nil
+}
+
+// idForAddress returns the ID of the server with the given address in the current configuration.
+func (a *admin) idForAddress(addr raft.ServerAddress) (raft.ServerID, error) {
+	f := a.r.GetConfiguration()
+	if err := f.Error(); err != nil {
+		return "", err
+	}
+	for _, s := range f.Configuration().Servers {
+		if s.Address == addr {
+			return s.ID, nil
+		}
+	}
+	return "", status.Errorf(codes.NotFound, "no server with address %q in the configuration", addr)
+}
+
+func (a *admin) RestoreSnapshot(stream pb.RaftAdmin_RestoreSnapshotServer) error {
+	if err := a.checkMaintenance(stream.Context()); err != nil {
+		return err
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := msg.GetMeta()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "the first message must contain the snapshot metadata")
+	}
+	if a.confirmationSecret != nil {
+		if err := a.checkConfirmation("RestoreSnapshot", restoreSnapshotEffect(meta), msg.GetConfirmationToken()); err != nil {
+			return err
+		}
+	}
+	// Spool the snapshot to disk first, so a broken upload never reaches Restore.
+	fh, err := os.CreateTemp("", "raftadmin-restore-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fh.Name())
+	defer fh.Close()
+	h := sha256.New()
+	w := io.MultiWriter(fh, h)
+	var size int64
+	var checksum []byte
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if checksum != nil {
+			return status.Error(codes.InvalidArgument, "the checksum must be the last message")
+		}
+		switch m := msg.GetMessage().(type) {
+		case *pb.RestoreSnapshotRequest_Data:
+			n, err := w.Write(m.Data)
+			if err != nil {
+				return err
+			}
+			size += int64(n)
+		case *pb.RestoreSnapshotRequest_Sha256:
+			checksum = m.Sha256
+		default:
+			return status.Errorf(codes.InvalidArgument, "unexpected message %T", m)
+		}
+	}
+	if size != meta.GetSize() {
+		return status.Errorf(codes.InvalidArgument, "received %d bytes, but the metadata says the snapshot is %d bytes", size, meta.GetSize())
+	}
+	if checksum == nil {
+		return status.Error(codes.InvalidArgument, "the last message must contain the SHA-256 of the snapshot")
+	}
+	if !bytes.Equal(checksum, h.Sum(nil)) {
+		return status.Errorf(codes.InvalidArgument, "checksum mismatch: got %x, received data has %x", checksum, h.Sum(nil))
+	}
+	if _, err := fh.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	err = a.r.Restore(&raft.SnapshotMeta{
+		Version: raft.SnapshotVersion(meta.GetVersion()),
+		ID:      meta.GetId(),
+		Index:   meta.GetIndex(),
+		Term:    meta.GetTerm(),
+		Size:    meta.GetSize(),
+	}, fh, timeout(stream.Context()))
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&pb.RestoreSnapshotResponse{})
+}
+
+func (a *admin) ServerInfo(ctx context.Context, req *pb.ServerInfoRequest) (*pb.ServerInfoResponse, error) {
+	ret := &pb.ServerInfoResponse{
+		RaftadminVersion: "(devel)",
+		RaftVersion:      "(devel)",
+		GoVersion:        runtime.Version(),
+		GoOs:             runtime.GOOS,
+		GoArch:           runtime.GOARCH,
+		Gomaxprocs:       int64(runtime.GOMAXPROCS(0)),
+		NumGoroutine:     int64(runtime.NumGoroutine()),
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		mods := append([]*debug.Module{&bi.Main}, bi.Deps...)
+		for _, m := range mods {
+			if m.Replace != nil && m.Replace.Version != "" {
+				m = m.Replace
+			}
+			if m.Version == "" {
+				continue
+			}
+			switch m.Path {
+			case "github.com/Jille/raftadmin":
+				ret.RaftadminVersion = m.Version
+			case "github.com/hashicorp/raft":
+				ret.RaftVersion = m.Version
+			}
+		}
+	}
+	stats := a.r.Stats()
+	for k, f := range map[string]*int64{
+		"protocol_version":     &ret.ProtocolVersion,
+		"protocol_version_min": &ret.ProtocolVersionMin,
+		"protocol_version_max": &ret.ProtocolVersionMax,
+		"snapshot_version_min": &ret.SnapshotVersionMin,
+		"snapshot_version_max": &ret.SnapshotVersionMax,
+	} {
+		n, err := strconv.ParseInt(stats[k], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", k, err)
+		}
+		*f = n
+	}
+	a.maintenance.mtx.Lock()
+	ret.Maintenance = a.maintenance.enabled
+	ret.MaintenanceReason = a.maintenance.reason
+	a.maintenance.mtx.Unlock()
+	ret.ConfirmationRequired = a.confirmationSecret != nil
+	return ret, nil
+}
+
+func (a *admin) Shutdown(ctx context.Context, req *pb.ShutdownRequest) (*pb.FutureResponse, error) {
+	if err := a.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	if a.confirmationSecret != nil {
+		effect, err := a.shutdownEffect()
+		if err != nil {
+			return nil, err
+		}
+		if err := a.checkConfirmation("Shutdown", effect, req.GetConfirmationToken()); err != nil {
+			return nil, err
+		}
+	}
+	return a.toFuture(ctx, func() raft.Future { return a.r.Shutdown() })
+}
+
+func (a *admin) Snapshot(ctx context.Context, req *pb.SnapshotRequest) (*pb.FutureResponse, error) {
+	return a.toFuture(ctx, func() raft.Future { return a.r.Snapshot() })
+}
+
+func (a *admin) StageRecovery(ctx context.Context, req *pb.StageRecoveryRequest) (*pb.StageRecoveryResponse, error) {
+	if a.recoveryFile == "" {
+		return nil, status.Error(codes.FailedPrecondition, "StageRecovery is disabled; use raftadmin.WithRecoveryFile to enable it")
+	}
+	if addr, id := a.r.LeaderWithID(); addr != "" && !req.GetForce() {
+		return nil, status.Errorf(codes.FailedPrecondition, "this node still knows of leader %s (%s); recovery is only meant for clusters that lost quorum", id, addr)
+	}
+	c, err := fromServers(req.GetServers())
+	if err != nil {
+		return nil, err
+	}
+	if err := writeRecoveryFile(a.recoveryFile, c); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.StageRecoveryResponse{
+		Path: a.recoveryFile,
+	}, nil
 }
 
 func (a *admin) State(ctx context.Context, req *pb.StateRequest) (*pb.StateResponse, error) {
-	switch s := a.r.State(); s {
+	s, err := toState(a.r.State())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StateResponse{State: s}, nil
+}
+
+func toState(s raft.RaftState) (pb.StateResponse_State, error) {
+	switch s {
 	case raft.Follower:
-		return &pb.StateResponse{State: pb.StateResponse_FOLLOWER}, nil
+		return pb.StateResponse_FOLLOWER, nil
 	case raft.Candidate:
-		return &pb.StateResponse{State: pb.StateResponse_CANDIDATE}, nil
+		return pb.StateResponse_CANDIDATE, nil
 	case raft.Leader:
-		return &pb.StateResponse{State: pb.StateResponse_LEADER}, nil
+		return pb.StateResponse_LEADER, nil
 	case raft.Shutdown:
-		return &pb.StateResponse{State: pb.StateResponse_SHUTDOWN}, nil
+		return pb.StateResponse_SHUTDOWN, nil
 	default:
-		return nil, fmt.Errorf("unknown raft state %v", s)
+		return 0, fmt.Errorf("unknown raft state %v", s)
 	}
 }
 
@@ -193,9 +1471,123 @@ func (a *admin) Stats(ctx context.Context, req *pb.StatsRequest) (*pb.StatsRespo
 	for k, v := range a.r.Stats() {
 		ret.Stats[k] = v
 	}
+
+	var err error
+	if ret.State, err = toState(a.r.State()); err != nil {
+		return nil, err
+	}
+	for k, f := range map[string]*uint64{
+		"term":                       &ret.Term,
+		"last_log_index":             &ret.LastLogIndex,
+		"last_log_term":              &ret.LastLogTerm,
+		"commit_index":               &ret.CommitIndex,
+		"applied_index":              &ret.AppliedIndex,
+		"fsm_pending":                &ret.FsmPending,
+		"last_snapshot_index":        &ret.LastSnapshotIndex,
+		"last_snapshot_term":         &ret.LastSnapshotTerm,
+		"latest_configuration_index": &ret.LatestConfigurationIndex,
+		"num_peers":                  &ret.NumPeers,
+	} {
+		if *f, err = strconv.ParseUint(ret.Stats[k], 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", k, err)
+		}
+	}
+	ret.LatestConfigurationIndex = a.configurationIndex(ret.GetLatestConfigurationIndex())
+	for k, f := range map[string]*int64{
+		"protocol_version":     &ret.ProtocolVersion,
+		"protocol_version_min": &ret.ProtocolVersionMin,
+		"protocol_version_max": &ret.ProtocolVersionMax,
+		"snapshot_version_min": &ret.SnapshotVersionMin,
+		"snapshot_version_max": &ret.SnapshotVersionMax,
+	} {
+		if *f, err = strconv.ParseInt(ret.Stats[k], 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", k, err)
+		}
+	}
+	if ret.LastContactNanos, err = parseLastContact(ret.Stats["last_contact"]); err != nil {
+		return nil, err
+	}
+	cf := a.r.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, err
+	}
+	if ret.LatestConfiguration, err = toServers(cf.Configuration()); err != nil {
+		return nil, err
+	}
 	return ret, nil
 }
 
-func (a *admin) VerifyLeader(ctx context.Context, req *pb.VerifyLeaderRequest) (*pb.Future, error) {
-	return toFuture(a.r.VerifyLeader())
+// parseLastContact parses the last_contact value of raft.Stats into nanoseconds, with -1 meaning never.
+func parseLastContact(lc string) (int64, error) {
+	switch lc {
+	case "never":
+		return -1, nil
+	case "0":
+		return 0, nil
+	default:
+		d, err := time.ParseDuration(lc)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse last_contact: %v", err)
+		}
+		return int64(d), nil
+	}
+}
+
+func (a *admin) StepDown(ctx context.Context, req *pb.StepDownRequest) (*pb.StepDownResponse, error) {
+	if a.r.State() != raft.Leader {
+		return nil, a.notLeaderError("StepDown")
+	}
+	_, self := a.r.LeaderWithID()
+	if err := a.drainLeadership(ctx, time.Duration(req.GetTimeoutMs())*time.Millisecond); err != nil {
+		return nil, err
+	}
+	// It takes a moment before we hear from the new leader.
+	addr, id := a.r.LeaderWithID()
+	for i := 0; (id == "" || id == self) && i < 100 && ctx.Err() == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		addr, id = a.r.LeaderWithID()
+	}
+	if id == self {
+		addr, id = "", ""
+	}
+	return &pb.StepDownResponse{
+		NewLeader: &pb.LeaderResponse{
+			Address: string(addr),
+			Id:      string(id),
+		},
+	}, nil
+}
+
+func (a *admin) VerifyLeader(ctx context.Context, req *pb.VerifyLeaderRequest) (*pb.FutureResponse, error) {
+	return a.toFuture(ctx, func() raft.Future { return a.r.VerifyLeader() })
+}
+
+func (a *admin) WaitForIndex(ctx context.Context, req *pb.WaitForIndexRequest) (*pb.WaitForIndexResponse, error) {
+	if ms := req.GetTimeoutMs(); ms > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(ms)*time.Millisecond)
+		defer cancel()
+	}
+	// raft doesn't notify about applied entries, so poll. This is cheap compared to clients polling over the network.
+	t := time.NewTicker(10 * time.Millisecond)
+	defer t.Stop()
+	for {
+		applied := a.r.AppliedIndex()
+		if applied >= req.GetIndex() {
+			return &pb.WaitForIndexResponse{
+				AppliedIndex: applied,
+			}, nil
+		}
+		if a.r.State() == raft.Shutdown {
+			return nil, status.Errorf(codes.Unavailable, "raft was shut down at applied index %d", applied)
+		}
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, status.Errorf(codes.DeadlineExceeded, "applied index is %d, still waiting for %d", applied, req.GetIndex())
+			}
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-t.C:
+		}
+	}
 }
@@ -0,0 +1,304 @@
+package raftadmin
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testFSM is an FSM that ignores everything.
+type testFSM struct{}
+
+func (testFSM) Apply(*raft.Log) interface{} { return nil }
+
+func (testFSM) Snapshot() (raft.FSMSnapshot, error) { return testSnapshot{}, nil }
+
+func (testFSM) Restore(rc io.ReadCloser) error { return rc.Close() }
+
+type testSnapshot struct{}
+
+func (testSnapshot) Persist(sink raft.SnapshotSink) error { return sink.Close() }
+
+func (testSnapshot) Release() {}
+
+// testNode is a raft node that talks to the other nodes of a test over raft.InmemTransport.
+type testNode struct {
+	id    raft.ServerID
+	addr  raft.ServerAddress
+	r     *raft.Raft
+	store *raft.InmemStore
+}
+
+// newTestNodes starts n connected raft nodes with in-memory storage. They have no configuration until bootstrap is called.
+func newTestNodes(t *testing.T, n int) []*testNode {
+	t.Helper()
+	nodes := make([]*testNode, n)
+	transports := make([]*raft.InmemTransport, n)
+	for i := range nodes {
+		nodes[i] = &testNode{
+			id:    raft.ServerID(fmt.Sprintf("node%d", i+1)),
+			store: raft.NewInmemStore(),
+		}
+		nodes[i].addr, transports[i] = raft.NewInmemTransport("")
+	}
+	for i := range transports {
+		for j := range transports {
+			if i != j {
+				transports[i].Connect(nodes[j].addr, transports[j])
+			}
+		}
+	}
+	for i, n := range nodes {
+		c := raft.DefaultConfig()
+		c.LocalID = n.id
+		c.HeartbeatTimeout = 50 * time.Millisecond
+		c.ElectionTimeout = 50 * time.Millisecond
+		c.LeaderLeaseTimeout = 50 * time.Millisecond
+		c.CommitTimeout = 5 * time.Millisecond
+		c.Logger = hclog.NewNullLogger()
+		r, err := raft.NewRaft(c, testFSM{}, n.store, n.store, raft.NewInmemSnapshotStore(), transports[i])
+		if err != nil {
+			t.Fatalf("NewRaft(%s) failed: %v", n.id, err)
+		}
+		n.r = r
+		t.Cleanup(func() {
+			r.Shutdown()
+		})
+	}
+	return nodes
+}
+
+// bootstrap makes the given nodes a cluster of voters and returns its leader.
+func bootstrap(t *testing.T, nodes ...*testNode) *testNode {
+	t.Helper()
+	var c raft.Configuration
+	for _, n := range nodes {
+		c.Servers = append(c.Servers, raft.Server{Suffrage: raft.Voter, ID: n.id, Address: n.addr})
+	}
+	if err := nodes[0].r.BootstrapCluster(c).Error(); err != nil {
+		t.Fatalf("BootstrapCluster failed: %v", err)
+	}
+	return waitForLeader(t, nodes...)
+}
+
+// waitForLeader returns the node that is the leader, once there is one.
+func waitForLeader(t *testing.T, nodes ...*testNode) *testNode {
+	t.Helper()
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		for _, n := range nodes {
+			if n.r.State() == raft.Leader {
+				return n
+			}
+		}
+	}
+	t.Fatal("no leader was elected")
+	return nil
+}
+
+// newTestServer creates a Server for r that is closed at the end of the test.
+func newTestServer(t *testing.T, r Raft, opts ...Option) *Server {
+	s := New(r, opts...)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// newTestClient serves s over an in-memory connection, so calls go through the same interceptors as in production.
+func newTestClient(t *testing.T, s *Server) pb.RaftAdminClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	s.Register(gs)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+	conn, err := grpc.Dial("bufconn", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+	})
+	return pb.NewRaftAdminClient(conn)
+}
+
+// awaitOK waits for the operation and fails the test if it failed.
+func awaitOK(t *testing.T, a *admin, f *pb.FutureResponse, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("starting the operation failed: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := a.AwaitAndForget(ctx, f.Future())
+	if err != nil {
+		t.Fatalf("AwaitAndForget failed: %v", err)
+	}
+	if resp.GetError() != "" {
+		t.Fatalf("operation failed: %s", resp.GetError())
+	}
+}
+
+// wantCode fails the test unless err has the given code and its message contains substr.
+func wantCode(t *testing.T, err error, code codes.Code, substr string) {
+	t.Helper()
+	if st := status.Convert(err); st.Code() != code || !strings.Contains(st.Message(), substr) {
+		t.Fatalf("got error %v, want %s containing %q", err, code, substr)
+	}
+}
+
+func hasServer(t *testing.T, r *raft.Raft, id raft.ServerID) bool {
+	t.Helper()
+	f := r.GetConfiguration()
+	if err := f.Error(); err != nil {
+		t.Fatalf("GetConfiguration failed: %v", err)
+	}
+	for _, s := range f.Configuration().Servers {
+		if s.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRemoveServerLeader(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	leader := bootstrap(t, nodes...)
+	s := newTestServer(t, leader.r)
+	ctx := context.Background()
+
+	_, err := s.a.RemoveServer(ctx, &pb.RemoveServerRequest{Id: string(leader.id)})
+	wantCode(t, err, codes.FailedPrecondition, "is the leader")
+	_, err = s.a.RemoveServer(ctx, &pb.RemoveServerRequest{Address: string(leader.addr)})
+	wantCode(t, err, codes.FailedPrecondition, "is the leader")
+	if !hasServer(t, leader.r, leader.id) {
+		t.Fatalf("%s was removed without force", leader.id)
+	}
+
+	f, err := s.a.RemoveServer(ctx, &pb.RemoveServerRequest{Id: string(leader.id), Force: true})
+	awaitOK(t, s.a, f, err)
+	var others []*testNode
+	for _, n := range nodes {
+		if n != leader {
+			others = append(others, n)
+		}
+	}
+	if n := waitForLeader(t, others...); hasServer(t, n.r, leader.id) {
+		t.Errorf("%s is still in the configuration after forcing its removal", leader.id)
+	}
+}
+
+// restore uploads data as snapshot with the given metadata, followed by sum if it isn't nil.
+func restore(c pb.RaftAdminClient, meta *pb.SnapshotMeta, token string, data, sum []byte) error {
+	stream, err := c.RestoreSnapshot(context.Background())
+	if err != nil {
+		return err
+	}
+	msgs := []*pb.RestoreSnapshotRequest{
+		{Message: &pb.RestoreSnapshotRequest_Meta{Meta: meta}, ConfirmationToken: token},
+		{Message: &pb.RestoreSnapshotRequest_Data{Data: data}},
+	}
+	if sum != nil {
+		msgs = append(msgs, &pb.RestoreSnapshotRequest{Message: &pb.RestoreSnapshotRequest_Sha256{Sha256: sum}})
+	}
+	for _, m := range msgs {
+		if err := stream.Send(m); err != nil {
+			// The server gave up early; CloseAndRecv returns why.
+			break
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	leader := bootstrap(t, nodes...)
+	data := []byte("snapshot data")
+	sum := sha256.Sum256(data)
+	meta := &pb.SnapshotMeta{Id: "1-2-3", Index: 2, Term: 1, Size: int64(len(data)), Version: 1}
+
+	c := newTestClient(t, newTestServer(t, leader.r))
+	wantCode(t, restore(c, meta, "", data, nil), codes.InvalidArgument, "SHA-256")
+	wantCode(t, restore(c, meta, "", data, make([]byte, len(sum))), codes.InvalidArgument, "checksum mismatch")
+
+	s := newTestServer(t, leader.r, WithConfirmation(time.Minute))
+	c = newTestClient(t, s)
+	err := restore(c, meta, "", data, sum[:])
+	wantCode(t, err, codes.FailedPrecondition, "confirmation_token")
+	token, effect, ok := pb.ConfirmationFromError(err)
+	if !ok || !strings.Contains(effect, "with snapshot 1-2-3") {
+		t.Fatalf("RestoreSnapshot without a token returned %v, want a token and the effect", err)
+	}
+	other := &pb.SnapshotMeta{Id: "1-2-4", Index: 2, Term: 1, Size: int64(len(data)), Version: 1}
+	wantCode(t, restore(c, other, token, data, sum[:]), codes.FailedPrecondition, "invalid or expired")
+
+	if _, err := s.a.EnterMaintenance(context.Background(), &pb.EnterMaintenanceRequest{Reason: "test"}); err != nil {
+		t.Fatalf("EnterMaintenance failed: %v", err)
+	}
+	wantCode(t, restore(c, meta, token, data, sum[:]), codes.FailedPrecondition, "maintenance mode")
+	if _, err := s.a.ExitMaintenance(context.Background(), &pb.ExitMaintenanceRequest{}); err != nil {
+		t.Fatalf("ExitMaintenance failed: %v", err)
+	}
+
+	if err := restore(c, meta, token, data, sum[:]); err != nil {
+		t.Fatalf("RestoreSnapshot with a token failed: %v", err)
+	}
+}
+
+func TestGetDoesNotWatchRaft(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	leader := bootstrap(t, nodes[0])
+	a := Get(leader.r, WithFutureTTL(time.Minute), WithStatsHistory(time.Second, 10), WithQuorumChecks()).(*admin)
+	if a.heartbeats != nil || a.metrics != nil {
+		t.Fatalf("Get started watching raft, which nobody can stop")
+	}
+	ctx := context.Background()
+
+	// Everything that uses the heartbeats works without them.
+	health, err := a.ServerHealth(ctx, &pb.ServerHealthRequest{})
+	if err != nil {
+		t.Fatalf("ServerHealth failed: %v", err)
+	}
+	if s := health.GetServers()[0]; !s.GetHealthy() || s.GetStableSinceUnixNano() != 0 {
+		t.Errorf("ServerHealth returned %v, want a healthy leader that is stable since an unknown time", s)
+	}
+	if _, err := a.PeerLastContact(ctx, &pb.PeerLastContactRequest{}); err != nil {
+		t.Errorf("PeerLastContact failed: %v", err)
+	}
+	_, err = a.AddVoter(ctx, &pb.AddVoterRequest{Id: string(nodes[1].id), Address: string(nodes[1].addr)})
+	wantCode(t, err, codes.FailedPrecondition, "needed for quorum")
+}
+
+func TestAwaitMetadata(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	leader := bootstrap(t, nodes...)
+	c := newTestClient(t, newTestServer(t, leader.r))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), pb.AwaitMetadataKey, "true")
+	resp, err := c.Barrier(ctx, &pb.BarrierRequest{})
+	if err != nil {
+		t.Fatalf("Barrier failed: %v", err)
+	}
+	if resp.GetResult() == nil || resp.GetResult().GetError() != "" || resp.GetResult().GetIndex() == 0 {
+		t.Fatalf("Barrier returned result %v, want the index it was applied at", resp.GetResult())
+	}
+	// The server forgot the operation after waiting for it.
+	_, err = c.Await(context.Background(), resp.Future())
+	wantCode(t, err, codes.Unknown, "unknown")
+}
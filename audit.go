@@ -0,0 +1,47 @@
+package raftadmin
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuditEvent describes an RPC handled by the server. See WithAuditSink.
+type AuditEvent struct {
+	// Time is when the call started.
+	Time time.Time
+	// Caller describes who sent the call: the common name of their verified TLS client certificate (if any) and their address.
+	Caller string
+	// Method is the name of the RPC, like "RemoveServer".
+	Method string
+	// Request is the request of the call. It is nil for streaming RPCs.
+	Request proto.Message
+	// Code is the status code the call ended with, codes.OK if it succeeded.
+	Code codes.Code
+	// Error is the error the call failed with, or nil.
+	Error error
+	// Latency is how long the call took, including rejecting it.
+	Latency time.Duration
+}
+
+// audit sends an event for a finished call to the sinks set by WithAuditSink.
+func (a *admin) audit(ctx context.Context, start time.Time, method string, req proto.Message, err error) {
+	if len(a.auditSinks) == 0 {
+		return
+	}
+	ev := AuditEvent{
+		Time:    start,
+		Caller:  callerOf(ctx),
+		Method:  method,
+		Request: req,
+		Code:    status.Code(err),
+		Error:   err,
+		Latency: time.Since(start),
+	}
+	for _, s := range a.auditSinks {
+		s(ev)
+	}
+}
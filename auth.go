@@ -0,0 +1,59 @@
+package raftadmin
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// checkToken verifies the bearer token in the metadata of the call with the verifier set by WithAuthToken or WithAuthTokenVerifier.
+func (a *admin) checkToken(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token := strings.TrimPrefix(vals[0], "Bearer ")
+	if token == vals[0] {
+		return status.Error(codes.Unauthenticated, "authorization metadata is not a bearer token")
+	}
+	if err := a.tokenVerifier(ctx, token); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+	}
+	return nil
+}
+
+// staticTokenVerifier returns a verifier that accepts only the given token.
+func staticTokenVerifier(want string) func(ctx context.Context, token string) error {
+	return func(ctx context.Context, token string) error {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(want)) != 1 {
+			return errors.New("token doesn't match")
+		}
+		return nil
+	}
+}
+
+// BearerToken returns credentials that send token the way WithAuthToken expects it. Pass them to WithPeerDialOptions with grpc.WithPerRPCCredentials if the other nodes require a token.
+// They don't require transport security, so use them with TLS unless the network is trusted.
+func BearerToken(token string) credentials.PerRPCCredentials {
+	return bearerToken(token)
+}
+
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,78 @@
+package raftadmin
+
+import (
+	"context"
+	"crypto/x509"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// CertificateRule allows clients whose TLS certificate matches Identity to call some methods. See WithCertificateRules.
+type CertificateRule struct {
+	// Identity is matched against the DNS names, URIs and common name of the client certificate, with the syntax of path.Match, like "admin.*.example.com".
+	Identity string
+	// Methods are the RPCs the client may call, like "RemoveServer". If empty, the client may call every RPC, or only the read-only ones if ReadOnly is set.
+	Methods []string
+	// ReadOnly allows the RPCs that WithReadOnly allows.
+	ReadOnly bool
+}
+
+// allows returns whether the rule allows method for a client with the given identities.
+func (r CertificateRule) allows(identities []string, method string) bool {
+	if !matchesAny([]string{r.Identity}, identities) {
+		return false
+	}
+	if r.ReadOnly && isReadOnlyMethod(method) {
+		return true
+	}
+	for _, m := range r.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return !r.ReadOnly && len(r.Methods) == 0
+}
+
+// ClientCertificate returns the verified TLS certificate the client of an RPC presented, if any.
+// It is useful in functions passed to WithAuthorizer.
+func ClientCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	ti, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(ti.State.VerifiedChains) == 0 || len(ti.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return ti.State.VerifiedChains[0][0], true
+}
+
+// certificateIdentities returns the names a certificate can be matched on by a CertificateRule.
+func certificateIdentities(cert *x509.Certificate) []string {
+	ids := append([]string{}, cert.DNSNames...)
+	for _, u := range cert.URIs {
+		ids = append(ids, u.String())
+	}
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, cert.Subject.CommonName)
+	}
+	return ids
+}
+
+// checkCertificate returns an error unless one of the rules set by WithCertificateRules allows the client to call method.
+func (a *admin) checkCertificate(ctx context.Context, method string) error {
+	cert, ok := ClientCertificate(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "a verified TLS client certificate is required")
+	}
+	ids := certificateIdentities(cert)
+	for _, r := range a.certificateRules {
+		if r.allows(ids, method) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed for %v", method, ids)
+}
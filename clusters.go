@@ -0,0 +1,277 @@
+package raftadmin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Clusters serves the RaftAdmin service for several raft groups in the same process. Calls are routed by the cluster ID in their pb.ClusterMetadataKey metadata.
+// Calls without it go to the only cluster, if there is just one, so clients that don't know about clusters keep working.
+type Clusters struct {
+	mtx      sync.RWMutex
+	clusters map[string]*cluster
+}
+
+type cluster struct {
+	s *Server
+	// The handlers of s for RaftAdmin and RaftInspect, wrapped to pass through its interceptors, by full method name.
+	methods map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
+
+	// extensions are the wrapped handlers of the extensions of s, created on first use so that other clusters can still add extensions until then.
+	extensionsOnce sync.Once
+	extensions     map[string]grpc.MethodDesc
+}
+
+// NewClusters creates an empty registry. Add raft groups with Add and serve them with Register.
+func NewClusters() *Clusters {
+	return &Clusters{clusters: map[string]*cluster{}}
+}
+
+// Add creates a RaftAdmin server for r like New, and serves it for calls with the given cluster ID. The options only apply to this cluster.
+// Calls the server makes to other nodes, like for WithLeaderForwarding and ReplicationStatus, are sent to the same cluster ID. Operation tokens only work for the cluster that returned them, and the metrics of its Collector have the cluster ID as "cluster" label.
+// Add panics if the ID is empty or already in use.
+func (c *Clusters) Add(id string, r Raft, opts ...Option) *Server {
+	if id == "" {
+		panic(fmt.Errorf("raftadmin: cluster IDs can't be empty"))
+	}
+	a := newAdmin(r, append(opts, func(a *admin) { a.clusterID = id })...)
+	cl := &cluster{
+		s:       &Server{a},
+		methods: map[string]grpc.MethodDesc{},
+		streams: map[string]grpc.StreamDesc{},
+	}
+	for _, d := range []*grpc.ServiceDesc{a.serviceDesc(), a.inspectServiceDesc()} {
+		for _, m := range d.Methods {
+			cl.methods["/"+d.ServiceName+"/"+m.MethodName] = m
+		}
+		for _, s := range d.Streams {
+			cl.streams["/"+d.ServiceName+"/"+s.StreamName] = s
+		}
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.clusters[id]; ok {
+		panic(fmt.Errorf("raftadmin: cluster %q was already added", id))
+	}
+	c.clusters[id] = cl
+	a.start()
+	return cl.s
+}
+
+// Remove stops serving the cluster with the given ID and closes its server with Close.
+func (c *Clusters) Remove(id string) {
+	c.mtx.Lock()
+	cl, ok := c.clusters[id]
+	delete(c.clusters, id)
+	c.mtx.Unlock()
+	if ok {
+		cl.s.Close()
+	}
+}
+
+// Register registers the RaftAdmin service on gs, routing every call to the cluster it is for.
+// If extensions were created with WithExtension, it also registers the RaftAdminExtensions service, routed the same way. Create them before calling Register.
+func (c *Clusters) Register(gs grpc.ServiceRegistrar) {
+	c.register(gs, pb.RaftAdminServiceDesc())
+
+	extensionsMtx.Lock()
+	hasExtensions := len(registeredExtensions) > 0
+	extensionsMtx.Unlock()
+	if !hasExtensions {
+		return
+	}
+	sd := extensionsDescriptor()
+	ed := grpc.ServiceDesc{
+		ServiceName: pb.ExtensionServiceName,
+		HandlerType: (*interface{})(nil),
+		Metadata:    sd.ParentFile().Path(),
+	}
+	for i := 0; sd.Methods().Len() > i; i++ {
+		name := string(sd.Methods().Get(i).Name())
+		ed.Methods = append(ed.Methods, grpc.MethodDesc{
+			MethodName: name,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				cl, err := c.route(ctx)
+				if err != nil {
+					return nil, err
+				}
+				m, ok := cl.extension(name)
+				if !ok {
+					return nil, status.Errorf(codes.Unimplemented, "this cluster has no extension %s", name)
+				}
+				return m.Handler(cl.s.a, ctx, dec, interceptor)
+			},
+		})
+	}
+	gs.RegisterService(&ed, nil)
+}
+
+// RegisterInspect registers the RaftInspect service on gs, routing every call to the cluster it is for. See Server.RegisterInspect.
+func (c *Clusters) RegisterInspect(gs grpc.ServiceRegistrar) {
+	c.register(gs, pb.RaftInspectServiceDesc())
+}
+
+// register registers the service described by d on gs, with handlers that route every call to the cluster it is for.
+func (c *Clusters) register(gs grpc.ServiceRegistrar, d grpc.ServiceDesc) {
+	for i, m := range d.Methods {
+		fullMethod := "/" + d.ServiceName + "/" + m.MethodName
+		if m.MethodName == "ListClusters" {
+			d.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return c.listClusters(ctx, fullMethod, dec, interceptor)
+			}
+			continue
+		}
+		d.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			cl, err := c.route(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return cl.methods[fullMethod].Handler(cl.s.a, ctx, dec, interceptor)
+		}
+	}
+	for i, s := range d.Streams {
+		fullMethod := "/" + d.ServiceName + "/" + s.StreamName
+		d.Streams[i].Handler = func(srv interface{}, stream grpc.ServerStream) error {
+			cl, err := c.route(stream.Context())
+			if err != nil {
+				return err
+			}
+			return cl.streams[fullMethod].Handler(cl.s.a, stream)
+		}
+	}
+	gs.RegisterService(&d, nil)
+}
+
+// extension returns the wrapped handler of an extension of the cluster.
+func (cl *cluster) extension(name string) (grpc.MethodDesc, bool) {
+	cl.extensionsOnce.Do(func() {
+		cl.extensions = map[string]grpc.MethodDesc{}
+		if d := cl.s.a.extensionServiceDesc(); d != nil {
+			for _, m := range d.Methods {
+				cl.extensions[m.MethodName] = m
+			}
+		}
+	})
+	m, ok := cl.extensions[name]
+	return m, ok
+}
+
+// route returns the cluster a call is for.
+func (c *Clusters) route(ctx context.Context) (*cluster, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(pb.ClusterMetadataKey)
+	if len(ids) == 0 {
+		switch len(c.clusters) {
+		case 0:
+			return nil, status.Error(codes.Unavailable, "this server hosts no clusters yet")
+		case 1:
+			for _, cl := range c.clusters {
+				return cl, nil
+			}
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "this server hosts several clusters; pick one of %s with the %s metadata", c.idsLocked(), pb.ClusterMetadataKey)
+	}
+	cl, ok := c.clusters[ids[0]]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown cluster %q; this server hosts %s", ids[0], c.idsLocked())
+	}
+	return cl, nil
+}
+
+// listClusters handles ListClusters by calling it on every cluster, through the interceptors of each, and merging the results. Clusters that reject the call are left out.
+func (c *Clusters) listClusters(ctx context.Context, fullMethod string, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := &pb.ListClustersRequest{}
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		c.mtx.RLock()
+		ids := make([]string, 0, len(c.clusters))
+		for id := range c.clusters {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		cls := make([]*cluster, len(ids))
+		for i, id := range ids {
+			cls[i] = c.clusters[id]
+		}
+		c.mtx.RUnlock()
+
+		ret := &pb.ListClustersResponse{}
+		var firstErr error
+		for _, cl := range cls {
+			resp, err := cl.methods[fullMethod].Handler(cl.s.a, ctx, func(m interface{}) error {
+				proto.Merge(m.(proto.Message), in)
+				return nil
+			}, nil)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			ret.Clusters = append(ret.Clusters, resp.(*pb.ListClustersResponse).GetClusters()...)
+		}
+		if len(ret.Clusters) == 0 && firstErr != nil {
+			return nil, firstErr
+		}
+		return ret, nil
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+}
+
+// ListClusters returns this raft group. Clusters merges the results of all of them.
+func (a *admin) ListClusters(ctx context.Context, req *pb.ListClustersRequest) (*pb.ListClustersResponse, error) {
+	state, err := toState(a.r.State())
+	if err != nil {
+		return nil, err
+	}
+	addr, id := a.r.LeaderWithID()
+	ret := &pb.ListClustersResponse_Cluster{
+		Id:    a.clusterID,
+		State: state,
+		Leader: &pb.LeaderResponse{
+			Address: string(addr),
+			Id:      string(id),
+		},
+	}
+	f := a.r.GetConfiguration()
+	if err := f.Error(); err != nil {
+		return nil, err
+	}
+	for _, s := range f.Configuration().Servers {
+		if s.Suffrage == raft.Voter {
+			ret.Voters++
+		} else {
+			ret.Nonvoters++
+		}
+	}
+	return &pb.ListClustersResponse{Clusters: []*pb.ListClustersResponse_Cluster{ret}}, nil
+}
+
+// idsLocked returns the sorted IDs of the clusters, for error messages. The caller must hold mtx.
+func (c *Clusters) idsLocked() string {
+	ids := make([]string, 0, len(c.clusters))
+	for id := range c.clusters {
+		ids = append(ids, fmt.Sprintf("%q", id))
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ", ")
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+// applyBatch applies every argument as a separate log entry with a single ApplyBatch call.
+func applyBatch(ctx context.Context, target string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> apply_batch <data> ...")
+	}
+	req := &pb.ApplyBatchRequest{}
+	for _, a := range args {
+		req.Entries = append(req.Entries, &pb.ApplyLogRequest{Data: []byte(a)})
+	}
+	if *dryRun {
+		log.Printf("Dry run: would invoke ApplyBatch(%d entries) on %s", len(req.GetEntries()), target)
+		return nil
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewRaftAdminClient(conn)
+	if !*assumeYes {
+		cfg, err := c.GetConfiguration(ctx, &pb.GetConfigurationRequest{})
+		if err != nil {
+			return err
+		}
+		if err := confirm(fmt.Sprintf("About to apply %d entries on %s.\nThe current configuration is:\n%s", len(req.GetEntries()), target, formatServers(cfg.GetServers()))); err != nil {
+			return err
+		}
+	}
+	log.Printf("Invoking ApplyBatch(%d entries)", len(req.GetEntries()))
+	resp, err := c.ApplyBatch(ctx, req)
+	if err != nil {
+		return err
+	}
+	printResponse(resp)
+	failed := 0
+	for _, r := range resp.GetResults() {
+		if r.GetError() != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d entries failed", failed, len(resp.GetResults()))
+	}
+	return nil
+}
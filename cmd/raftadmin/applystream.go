@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+// applyStream applies every line of a file (or stdin) as a separate log entry, streaming them to the server with ApplyStream.
+func applyStream(ctx context.Context, target string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("Usage: raftadmin <host:port> apply_stream <file|->")
+	}
+	var in io.Reader = os.Stdin
+	if args[0] != "-" {
+		fh, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		in = fh
+	} else if !*assumeYes && !*dryRun {
+		return fmt.Errorf("can't ask for confirmation while reading entries from stdin; pass --yes")
+	}
+	if *dryRun {
+		log.Printf("Dry run: would invoke ApplyStream with every line of %s on %s", args[0], target)
+		return nil
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewRaftAdminClient(conn)
+	if !*assumeYes {
+		cfg, err := c.GetConfiguration(ctx, &pb.GetConfigurationRequest{})
+		if err != nil {
+			return err
+		}
+		if err := confirm(fmt.Sprintf("About to apply every line of %s as an entry on %s.\nThe current configuration is:\n%s", args[0], target, formatServers(cfg.GetServers()))); err != nil {
+			return err
+		}
+	}
+
+	stream, err := c.ApplyStream(ctx)
+	if err != nil {
+		return err
+	}
+	sendErr := make(chan error, 1)
+	go func() {
+		sc := bufio.NewScanner(in)
+		sc.Buffer(nil, 64*1024*1024)
+		for sc.Scan() {
+			if err := stream.Send(&pb.ApplyLogRequest{Data: append([]byte{}, sc.Bytes()...)}); err != nil {
+				// The server aborted; Recv will return the actual error.
+				sendErr <- nil
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			sendErr <- err
+			return
+		}
+		sendErr <- stream.CloseSend()
+	}()
+
+	var applied, failed int
+	var lastIndex uint64
+	t := time.NewTicker(2 * time.Second)
+	defer t.Stop()
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if r.GetError() != "" {
+			failed++
+			if failed <= 10 {
+				log.Printf("Entry %d failed: %s", applied+failed, r.GetError())
+			}
+		} else {
+			applied++
+			lastIndex = r.GetIndex()
+		}
+		select {
+		case <-t.C:
+			log.Printf("Applied %d entries so far (last index %d), %d failed", applied, lastIndex, failed)
+		default:
+		}
+	}
+	if err := <-sendErr; err != nil {
+		return err
+	}
+	log.Printf("Applied %d entries (last index %d)", applied, lastIndex)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d entries failed", failed, applied+failed)
+	}
+	return nil
+}
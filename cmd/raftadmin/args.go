@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"strings"
+)
+
+// flagName returns the name of the flag in arg (like --name=value), or "" if arg isn't a flag.
+func flagName(arg string) string {
+	if len(arg) < 2 || arg[0] != '-' || arg == "--" {
+		return ""
+	}
+	name := strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+	if i := strings.IndexByte(name, '='); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// takesValue returns whether the flag in arg consumes the next argument as its value.
+func takesValue(f *flag.Flag, arg string) bool {
+	if strings.Contains(arg, "=") {
+		return false
+	}
+	if bf, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && bf.IsBoolFlag() {
+		return false
+	}
+	return true
+}
+
+// extractFlags splits args into the flags defined in fs (with their values) and everything else, so flags can be given anywhere on the command line.
+// Everything after "--" is left alone.
+func extractFlags(fs *flag.FlagSet, args []string) (flags, rest []string) {
+	for i := 0; len(args) > i; i++ {
+		if args[i] == "--" {
+			return flags, append(rest, args[i:]...)
+		}
+		name := flagName(args[i])
+		if name == "h" || name == "help" {
+			// Let fs.Parse print the usage.
+			flags = append(flags, args[i])
+			continue
+		}
+		f := fs.Lookup(name)
+		if f == nil {
+			rest = append(rest, args[i])
+			continue
+		}
+		flags = append(flags, args[i])
+		if takesValue(f, args[i]) && len(args) > i+1 {
+			i++
+			flags = append(flags, args[i])
+		}
+	}
+	return flags, rest
+}
+
+// parseInterspersed is like fs.Parse, but also accepts flags after positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) error {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		if args[0] == "--" {
+			positional = append(positional, args[1:]...)
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	return fs.Parse(append([]string{"--"}, positional...))
+}
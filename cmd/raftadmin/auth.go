@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/Jille/raftadmin"
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc"
+)
+
+// authDialOptions returns the dial options that send the token from --auth-token or $RAFTADMIN_AUTH_TOKEN and the cluster from --cluster or $RAFTADMIN_CLUSTER, if any.
+func authDialOptions() []grpc.DialOption {
+	token := *authToken
+	if token == "" {
+		token = os.Getenv("RAFTADMIN_AUTH_TOKEN")
+	}
+	var opts []grpc.DialOption
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(raftadmin.BearerToken(token)))
+	}
+	cluster := *clusterFlag
+	if cluster == "" {
+		cluster = os.Getenv("RAFTADMIN_CLUSTER")
+	}
+	if cluster != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(clusterID(cluster)))
+	}
+	return opts
+}
+
+// clusterID sends the cluster ID in the metadata of every call, for servers that host several raft groups with raftadmin.Clusters.
+type clusterID string
+
+func (c clusterID) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{pb.ClusterMetadataKey: string(c)}, nil
+}
+
+func (c clusterID) RequireTransportSecurity() bool {
+	return false
+}
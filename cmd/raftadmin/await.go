@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// awaitProgressInterval is how often awaitFuture reports that it's still waiting.
+const awaitProgressInterval = 2 * time.Second
+
+// awaitCommand waits for a future started earlier (e.g. with --no-await) and then forgets it.
+func awaitCommand(ctx context.Context, target string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("Usage: raftadmin <host:port> await <operation_token>")
+	}
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return awaitFuture(ctx, conn, target, &pb.Future{OperationToken: args[0]})
+}
+
+// awaitFuture calls AwaitAndForget for f while periodically reporting progress.
+// If interrupted, it leaves the future on the server and explains how to resume waiting for it.
+func awaitFuture(ctx context.Context, conn *grpc.ClientConn, target string, f *pb.Future) error {
+	c := pb.NewRaftAdminClient(conn)
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		start := time.Now()
+		t := time.NewTicker(awaitProgressInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+			}
+			elapsed := time.Since(start).Round(time.Second)
+			if resp, err := c.AppliedIndex(ctx, &pb.AppliedIndexRequest{}); err == nil {
+				log.Printf("Still waiting after %s; applied index is %d", elapsed, resp.GetIndex())
+			} else {
+				log.Printf("Still waiting after %s", elapsed)
+			}
+		}
+	}()
+
+	log.Printf("Invoking AwaitAndForget(%s)", prototext.Format(f))
+	resp, err := c.AwaitAndForget(ctx, f)
+	forget := false
+	if status.Code(err) == codes.Unimplemented {
+		// Older servers don't have AwaitAndForget.
+		resp, err = c.Await(ctx, f)
+		forget = true
+	}
+	if err != nil {
+		if ctx.Err() != nil && ctx.Err() != context.DeadlineExceeded {
+			log.Printf("Interrupted. The operation continues on the server. Resume waiting for it with:\n  raftadmin %s await %s", target, f.GetOperationToken())
+			return fmt.Errorf("interrupted")
+		}
+		return err
+	}
+	printResponse(resp)
+	if l := resp.GetLeader(); l.GetAddress() != "" {
+		log.Printf("%s isn't the leader. Send the command to the leader %s at %s instead.", target, l.GetId(), l.GetAddress())
+	}
+	if !forget {
+		return nil
+	}
+	_, err = c.Forget(context.Background(), f)
+	return err
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// parseServers parses servers given as <id>=<address>[=<suffrage>].
+func parseServers(args []string) ([]*pb.GetConfigurationResponse_Server, error) {
+	var ret []*pb.GetConfigurationResponse_Server
+	for _, a := range args {
+		sp := strings.Split(a, "=")
+		if len(sp) < 2 || len(sp) > 3 || sp[0] == "" || sp[1] == "" {
+			return nil, fmt.Errorf("invalid server %q; expected <id>=<address>[=voter|nonvoter]", a)
+		}
+		s := &pb.GetConfigurationResponse_Server{Id: sp[0], Address: sp[1]}
+		if len(sp) == 3 {
+			v, ok := pb.GetConfigurationResponse_Server_Suffrage_value[strings.ToUpper(sp[2])]
+			if !ok {
+				return nil, fmt.Errorf("invalid suffrage %q for server %q", sp[2], sp[0])
+			}
+			s.Suffrage = pb.GetConfigurationResponse_Server_Suffrage(v)
+		}
+		ret = append(ret, s)
+	}
+	return ret, nil
+}
+
+// bootstrapCluster parses servers given as <id>=<address>[=<suffrage>] and calls BootstrapCluster.
+func bootstrapCluster(ctx context.Context, target string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> bootstrap_cluster <id>=<address>[=voter|nonvoter] ...")
+	}
+	servers, err := parseServers(args)
+	if err != nil {
+		return err
+	}
+	req := &pb.BootstrapClusterRequest{Servers: servers}
+	if *dryRun {
+		log.Printf("Dry run: would invoke BootstrapCluster(%s) on %s", strings.TrimSpace(prototext.Format(req)), target)
+		return nil
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	log.Printf("Invoking BootstrapCluster(%s)", prototext.Format(req))
+	f, err := pb.NewRaftAdminClient(conn).BootstrapCluster(ctx, req)
+	if err != nil {
+		return err
+	}
+	printResponse(f)
+	if *noAwait {
+		log.Printf("Not waiting for the operation. Collect its result later with:\n  raftadmin %s await %s", target, f.GetOperationToken())
+		return nil
+	}
+	return awaitFuture(ctx, conn, target, f.Future())
+}
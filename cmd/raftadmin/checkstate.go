@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+// Exit codes of check-state. They follow the Nagios plugin conventions.
+const (
+	exitStateWarning  = 1
+	exitStateCritical = 2
+	exitStateUnknown  = 3
+)
+
+// checkState exits with a code that depends on the state of the node, for use by monitoring systems and health hooks.
+// Without --expect, leaders exit 0, followers 1 and anything else 2. With --expect, it exits 0 if the state matches and 2 otherwise.
+func checkState(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("check-state", flag.ContinueOnError)
+	expect := fs.String("expect", "", "The state the node should be in: leader, follower, candidate or shutdown")
+	timeout := fs.Duration("timeout", 5*time.Second, "How long to wait for the node's state after connecting")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> check-state [--expect=leader] [--timeout=5s]")
+	}
+	var want pb.StateResponse_State
+	if *expect != "" {
+		v, ok := pb.StateResponse_State_value[strings.ToUpper(*expect)]
+		if !ok {
+			return fmt.Errorf("unknown state %q for --expect", *expect)
+		}
+		want = pb.StateResponse_State(v)
+	}
+
+	// An unreachable or hanging node is reported as unknown once --dial-timeout or --timeout passes, rather than hanging the check.
+	conn, err := dial(target)
+	if err != nil {
+		return exitError{exitStateUnknown, err}
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	resp, err := pb.NewRaftAdminClient(conn).State(ctx, &pb.StateRequest{})
+	if err != nil {
+		return exitError{exitStateUnknown, err}
+	}
+	state := resp.GetState()
+
+	if *expect != "" {
+		if state != want {
+			return exitError{exitStateCritical, fmt.Errorf("CRITICAL: %s is %s, expected %s", target, state, want)}
+		}
+		fmt.Printf("OK: %s is %s\n", target, state)
+		return nil
+	}
+	switch state {
+	case pb.StateResponse_LEADER:
+		fmt.Printf("OK: %s is %s\n", target, state)
+		return nil
+	case pb.StateResponse_FOLLOWER:
+		return exitError{exitStateWarning, fmt.Errorf("WARNING: %s is %s", target, state)}
+	default:
+		return exitError{exitStateCritical, fmt.Errorf("CRITICAL: %s is %s", target, state)}
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+func TestCheckState(t *testing.T) {
+	defer func(d time.Duration) { *dialTimeout = d }(*dialTimeout)
+	*dialTimeout = 200 * time.Millisecond
+	ctx := context.Background()
+	follower := serve(t, &fakeNode{state: pb.StateResponse_FOLLOWER})
+
+	for _, tc := range []struct {
+		target string
+		args   []string
+		want   int
+	}{
+		{follower, nil, exitStateWarning},
+		{follower, []string{"--expect=follower"}, 0},
+		{follower, []string{"--expect=leader"}, exitStateCritical},
+		{unreachable(t), nil, exitStateUnknown},
+		{serve(t, &fakeNode{hang: true}), []string{"--timeout=200ms"}, exitStateUnknown},
+	} {
+		start := time.Now()
+		err := checkState(ctx, tc.target, tc.args)
+		code := 0
+		if err != nil {
+			var ee exitError
+			if !errors.As(err, &ee) {
+				t.Fatalf("check-state %v returned %v, which has no exit code", tc.args, err)
+			}
+			code = ee.code
+		}
+		if code != tc.want {
+			t.Errorf("check-state %v exited %d (%v), want %d", tc.args, code, err, tc.want)
+		}
+		if d := time.Since(start); d > 5*time.Second {
+			t.Errorf("check-state %v took %s", tc.args, d)
+		}
+	}
+}
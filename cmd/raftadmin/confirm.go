@@ -0,0 +1,21 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// confirm prints the prompt and asks the user to type yes. It returns an error unless they do.
+func confirm(prompt string) error {
+	fmt.Fprintf(os.Stderr, "%s\nType 'yes' to continue: ", prompt)
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read confirmation: %v", err)
+	}
+	if strings.TrimSpace(line) != "yes" {
+		return fmt.Errorf("aborted")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+type exporter struct {
+	up            *prometheus.GaugeVec
+	state         *prometheus.GaugeVec
+	term          *prometheus.GaugeVec
+	lastLogIndex  *prometheus.GaugeVec
+	commitIndex   *prometheus.GaugeVec
+	appliedIndex  *prometheus.GaugeVec
+	snapshotIndex *prometheus.GaugeVec
+	fsmPending    *prometheus.GaugeVec
+	lastContact   *prometheus.GaugeVec
+	numPeers      *prometheus.GaugeVec
+	scrapeErrors  *prometheus.CounterVec
+}
+
+func newExporter(reg prometheus.Registerer) *exporter {
+	gauge := func(name, help string, labels ...string) *prometheus.GaugeVec {
+		g := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, append([]string{"node"}, labels...))
+		reg.MustRegister(g)
+		return g
+	}
+	e := &exporter{
+		up:            gauge("raft_up", "Whether the node responded to the last scrape."),
+		state:         gauge("raft_state", "The raft state of the node; 1 for the current state, 0 for the others.", "state"),
+		term:          gauge("raft_term", "The current term of the node."),
+		lastLogIndex:  gauge("raft_last_log_index", "The last index in the log of the node."),
+		commitIndex:   gauge("raft_commit_index", "The commit index of the node."),
+		appliedIndex:  gauge("raft_applied_index", "The last index applied to the FSM of the node."),
+		snapshotIndex: gauge("raft_last_snapshot_index", "The index of the last snapshot of the node."),
+		fsmPending:    gauge("raft_fsm_pending", "The number of entries waiting to be applied to the FSM of the node."),
+		lastContact:   gauge("raft_last_contact_seconds", "Seconds since the node last heard from the leader. 0 on the leader, -1 if it never did."),
+		numPeers:      gauge("raft_num_peers", "The number of other voters the node knows about."),
+		scrapeErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "raft_scrape_errors_total",
+			Help: "The number of failed scrapes of the node.",
+		}, []string{"node"}),
+	}
+	reg.MustRegister(e.scrapeErrors)
+	return e
+}
+
+// export periodically scrapes all nodes in the target list and serves the results as Prometheus metrics.
+func export(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	listen := fs.String("listen", ":9090", "Address to serve /metrics on")
+	interval := fs.Duration("interval", 15*time.Second, "How often to scrape the nodes")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> export [--listen=:9090] [--interval=15s]")
+	}
+
+	endpoints := splitTargets(target)
+	reg := prometheus.NewRegistry()
+	e := newExporter(reg)
+	go func() {
+		t := time.NewTicker(*interval)
+		defer t.Stop()
+		for {
+			var wg sync.WaitGroup
+			for _, node := range endpoints {
+				wg.Add(1)
+				go func(node string) {
+					defer wg.Done()
+					ctx, cancel := context.WithTimeout(ctx, *interval)
+					defer cancel()
+					// Get the connection from the pool every time, so broken ones are replaced.
+					conn, err := pool.get(node)
+					if err == nil {
+						err = e.scrape(ctx, node, pb.NewRaftAdminClient(conn))
+					}
+					if err != nil {
+						log.Printf("Failed to scrape %s: %v", node, err)
+						e.up.WithLabelValues(node).Set(0)
+						e.scrapeErrors.WithLabelValues(node).Inc()
+						return
+					}
+					e.up.WithLabelValues(node).Set(1)
+				}(node)
+			}
+			wg.Wait()
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	log.Printf("Serving metrics for %d nodes on %s/metrics", len(endpoints), *listen)
+	return http.ListenAndServe(*listen, mux)
+}
+
+// scrape fetches the Stats, AppliedIndex and State of a single node and updates the metrics.
+func (e *exporter) scrape(ctx context.Context, node string, c pb.RaftAdminClient) error {
+	stats, err := c.Stats(ctx, &pb.StatsRequest{})
+	if err != nil {
+		return err
+	}
+	applied, err := c.AppliedIndex(ctx, &pb.AppliedIndexRequest{})
+	if err != nil {
+		return err
+	}
+	state, err := c.State(ctx, &pb.StateRequest{})
+	if err != nil {
+		return err
+	}
+
+	for v, name := range pb.StateResponse_State_name {
+		var g float64
+		if pb.StateResponse_State(v) == state.GetState() {
+			g = 1
+		}
+		e.state.WithLabelValues(node, strings.ToLower(name)).Set(g)
+	}
+	e.appliedIndex.WithLabelValues(node).Set(float64(applied.GetIndex()))
+
+	s := stats.GetStats()
+	for g, key := range map[*prometheus.GaugeVec]string{
+		e.term:          "term",
+		e.lastLogIndex:  "last_log_index",
+		e.commitIndex:   "commit_index",
+		e.snapshotIndex: "last_snapshot_index",
+		e.fsmPending:    "fsm_pending",
+		e.numPeers:      "num_peers",
+	} {
+		if n, err := strconv.ParseUint(s[key], 10, 64); err == nil {
+			g.WithLabelValues(node).Set(float64(n))
+		}
+	}
+	switch lc := s["last_contact"]; lc {
+	case "never":
+		e.lastContact.WithLabelValues(node).Set(-1)
+	case "0":
+		e.lastContact.WithLabelValues(node).Set(0)
+	default:
+		if d, err := time.ParseDuration(lc); err == nil {
+			e.lastContact.WithLabelValues(node).Set(d.Seconds())
+		}
+	}
+	return nil
+}
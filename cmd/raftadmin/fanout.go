@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// splitTargets turns a target like multi:///host1:port,host2:port into a list of individual endpoints.
+func splitTargets(target string) []string {
+	target = strings.TrimPrefix(target, "multi:///")
+	var ret []string
+	for _, t := range strings.Split(target, ",") {
+		if t != "" {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+type fanoutResult struct {
+	resp protoreflect.Message
+	err  error
+}
+
+// fanout sends the request to all endpoints in parallel and prints the responses keyed by endpoint.
+// It returns an error if any of the endpoints failed.
+func fanout(ctx context.Context, endpoints []string, m protoreflect.MethodDescriptor, req protoreflect.Message) error {
+	log.Printf("Invoking %s(%s) on %d endpoints", m.Name(), prototext.Format(req.Interface()), len(endpoints))
+	results := fanoutInvoke(ctx, endpoints, m, req)
+	failed := 0
+	for i, e := range endpoints {
+		if err := results[i].err; err != nil {
+			failed++
+			log.Printf("%s: Error: %v", e, err)
+			continue
+		}
+		log.Printf("%s: Response: %s", e, prototext.Format(results[i].resp.Interface()))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d endpoints failed", failed, len(endpoints))
+	}
+	return nil
+}
+
+// fanoutInvoke sends the request to all endpoints in parallel and returns the results in the same order as endpoints.
+func fanoutInvoke(ctx context.Context, endpoints []string, m protoreflect.MethodDescriptor, req protoreflect.Message) []fanoutResult {
+	results := make([]fanoutResult, len(endpoints))
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, e string) {
+			defer wg.Done()
+			results[i].resp, results[i].err = invokeOn(ctx, e, m, req)
+		}(i, e)
+	}
+	wg.Wait()
+	return results
+}
+
+// invokeOn sends the RPC to a single endpoint over a pooled connection. It doesn't block on connecting, so unreachable nodes fail fast.
+func invokeOn(ctx context.Context, endpoint string, m protoreflect.MethodDescriptor, req protoreflect.Message) (protoreflect.Message, error) {
+	conn, err := pool.get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return invoke(ctx, conn, m, req)
+}
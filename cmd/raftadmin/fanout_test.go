@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fakeNode serves RaftAdmin with canned responses.
+type fakeNode struct {
+	pb.UnimplementedRaftAdminServer
+	state  pb.StateResponse_State
+	leader string
+	stats  *pb.StatsResponse
+	info   *pb.ClusterInfoResponse
+	// hang makes State and Stats block until the call is cancelled.
+	hang bool
+}
+
+func (n *fakeNode) State(ctx context.Context, req *pb.StateRequest) (*pb.StateResponse, error) {
+	if n.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return &pb.StateResponse{State: n.state}, nil
+}
+
+func (n *fakeNode) Leader(ctx context.Context, req *pb.LeaderRequest) (*pb.LeaderResponse, error) {
+	return &pb.LeaderResponse{Address: n.leader}, nil
+}
+
+func (n *fakeNode) Stats(ctx context.Context, req *pb.StatsRequest) (*pb.StatsResponse, error) {
+	if n.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return n.stats, nil
+}
+
+func (n *fakeNode) ClusterInfo(ctx context.Context, req *pb.ClusterInfoRequest) (*pb.ClusterInfoResponse, error) {
+	return n.info, nil
+}
+
+// serve serves srv on a local port until the end of the test, and returns its address.
+func serve(t *testing.T, srv pb.RaftAdminServer) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	gs := grpc.NewServer()
+	pb.RegisterRaftAdminServer(gs, srv)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+	t.Cleanup(pool.closeAll)
+	return lis.Addr().String()
+}
+
+// unreachable returns an address nobody listens on.
+func unreachable(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+	return addr
+}
+
+func method(name protoreflect.Name) protoreflect.MethodDescriptor {
+	return pb.File_raftadmin_proto.Services().ByName("RaftAdmin").Methods().ByName(name)
+}
+
+func TestFanout(t *testing.T) {
+	leader := serve(t, &fakeNode{state: pb.StateResponse_LEADER})
+	follower := serve(t, &fakeNode{state: pb.StateResponse_FOLLOWER})
+	down := unreachable(t)
+	endpoints := splitTargets("multi:///" + strings.Join([]string{leader, follower, down}, ","))
+	if len(endpoints) != 3 {
+		t.Fatalf("splitTargets returned %q, want the 3 endpoints", endpoints)
+	}
+	m := method("State")
+	req := (&pb.StateRequest{}).ProtoReflect()
+	ctx := context.Background()
+
+	results := fanoutInvoke(ctx, endpoints, m, req)
+	for i, want := range []pb.StateResponse_State{pb.StateResponse_LEADER, pb.StateResponse_FOLLOWER} {
+		if err := results[i].err; err != nil {
+			t.Fatalf("State on %s failed: %v", endpoints[i], err)
+		}
+		if got := pb.StateResponse_State(results[i].resp.Get(m.Output().Fields().ByName("state")).Enum()); got != want {
+			t.Errorf("State on %s returned %s, want %s", endpoints[i], got, want)
+		}
+	}
+	if results[2].err == nil {
+		t.Errorf("State on unreachable %s succeeded", down)
+	}
+
+	if err := fanout(ctx, endpoints, m, req); err == nil || !strings.Contains(err.Error(), "1 of 3 endpoints failed") {
+		t.Errorf("fanout returned %v, want it to report the unreachable endpoint", err)
+	}
+	if err := fanout(ctx, endpoints[:2], m, req); err != nil {
+		t.Errorf("fanout to reachable endpoints failed: %v", err)
+	}
+}
+
+func TestFanoutOnlyReadOnly(t *testing.T) {
+	for _, name := range []protoreflect.Name{"State", "GetConfiguration", "Stats"} {
+		if !isReadOnly(method(name)) {
+			t.Errorf("%s isn't considered read-only, so it can't be sent with --all", name)
+		}
+	}
+	for _, name := range []protoreflect.Name{"AddVoter", "RemoveServer", "Shutdown", "ApplyLog"} {
+		if m := method(name); isReadOnly(m) || perNodeMethods[m.Name()] {
+			t.Errorf("%s can be sent to every node with --all", name)
+		}
+	}
+}
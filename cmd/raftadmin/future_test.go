@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestAsFuture(t *testing.T) {
+	resp := &pb.FutureResponse{OperationToken: "new", Warnings: []string{"even"}}
+	if f, ok := asFuture(resp.ProtoReflect()); !ok || f.GetOperationToken() != "new" || len(f.GetWarnings()) != 1 {
+		t.Errorf("asFuture(%v) = %v, %v", resp, f, ok)
+	}
+	// With --reflection, responses are dynamic messages.
+	dyn := dynamicpb.NewMessage(resp.ProtoReflect().Descriptor())
+	dyn.Set(dyn.Descriptor().Fields().ByName("operation_token"), protoreflect.ValueOfString("new"))
+	if f, ok := asFuture(dyn); !ok || f.GetOperationToken() != "new" {
+		t.Errorf("asFuture of a dynamic FutureResponse = %v, %v", f, ok)
+	}
+	// Older servers return the Future itself.
+	old := &pb.Future{OperationToken: "old"}
+	if f, ok := asFuture(old.ProtoReflect()); !ok || f.GetOperationToken() != "old" {
+		t.Errorf("asFuture(%v) = %v, %v", old, f, ok)
+	}
+	if f, ok := asFuture((&pb.StateResponse{}).ProtoReflect()); ok {
+		t.Errorf("asFuture(StateResponse) = %v, want no future", f)
+	}
+}
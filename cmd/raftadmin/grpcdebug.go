@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/peer"
+)
+
+// enableGRPCDebug makes gRPC log its resolver, balancer, health check and transport decisions to stderr.
+// It must be called before any connection is made.
+func enableGRPCDebug() {
+	grpclog.SetLoggerV2(grpclog.NewLoggerV2WithVerbosity(os.Stderr, os.Stderr, os.Stderr, 2))
+}
+
+// debugDialOptions returns the dial options that log which address each RPC was sent to, if --grpc-debug is set.
+func debugDialOptions() []grpc.DialOption {
+	if !*grpcDebug {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			var p peer.Peer
+			err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Peer(&p))...)
+			log.Printf("[grpc-debug] %s on %s was sent to %v: %v", method, cc.Target(), p.Addr, err)
+			return err
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			s, err := streamer(ctx, desc, cc, method, opts...)
+			if err == nil {
+				if p, ok := peer.FromContext(s.Context()); ok {
+					log.Printf("[grpc-debug] stream %s on %s was opened to %v", method, cc.Target(), p.Addr)
+				}
+			}
+			return s, err
+		}),
+	}
+}
+
+// logConnectivity logs every connectivity state change of conn until it is closed, if --grpc-debug is set.
+func logConnectivity(conn *grpc.ClientConn) {
+	if !*grpcDebug {
+		return
+	}
+	go func() {
+		s := conn.GetState()
+		log.Printf("[grpc-debug] %s is %s", conn.Target(), s)
+		for conn.WaitForStateChange(context.Background(), s) {
+			s = conn.GetState()
+			log.Printf("[grpc-debug] %s is now %s", conn.Target(), s)
+		}
+	}()
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// statsHistory shows the stats a node sampled over time, as configured with raftadmin.WithStatsHistory.
+func statsHistory(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("stats_history", flag.ContinueOnError)
+	since := fs.Duration("since", 0, "Only show samples taken in this period before now (0 for all)")
+	until := fs.Duration("until", 0, "Only show samples taken at least this long ago")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> stats_history [--since=10m] [--until=5m]")
+	}
+	now := time.Now()
+	req := &pb.StatsHistoryRequest{}
+	if *since > 0 {
+		req.SinceUnixNano = now.Add(-*since).UnixNano()
+	}
+	if *until > 0 {
+		req.UntilUnixNano = now.Add(-*until).UnixNano()
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := pb.NewRaftAdminClient(conn).StatsHistory(ctx, req)
+	if err != nil {
+		return err
+	}
+	if *output == "json" {
+		fmt.Println(protojson.Format(resp))
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tSTATE\tTERM\tLEADER\tLAST INDEX\tCOMMIT INDEX\tAPPLIED INDEX\tFSM PENDING\tSNAPSHOT INDEX\tLAST CONTACT")
+	for _, s := range resp.GetSamples() {
+		lastContact := time.Duration(s.GetLastContactNanos()).String()
+		if s.GetLastContactNanos() < 0 {
+			lastContact = "never"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%d\t%d\t%d\t%d\t%d\t%s\n", time.Unix(0, s.GetTimeUnixNano()).Format(time.RFC3339), s.GetState(), s.GetTerm(), s.GetLeaderId(), s.GetLastLogIndex(), s.GetCommitIndex(), s.GetAppliedIndex(), s.GetFsmPending(), s.GetLastSnapshotIndex(), lastContact)
+	}
+	return tw.Flush()
+}
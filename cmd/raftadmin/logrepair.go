@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+// deleteLogRange deletes entries from the LogStore of a node whose raft was shut down. It asks the server for a confirmation token, shows what would be deleted and only then sends the token back.
+func deleteLogRange(ctx context.Context, target string, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("Usage: raftadmin <host:port> delete_log_range <min_index> <max_index>")
+	}
+	min, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid min_index %q: %v", args[0], err)
+	}
+	max, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid max_index %q: %v", args[1], err)
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewRaftAdminClient(conn)
+	// Without a token the server only checks the request and returns the token.
+	resp, err := c.DeleteLogRange(ctx, &pb.DeleteLogRangeRequest{MinIndex: min, MaxIndex: max})
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		log.Printf("Dry run: would delete log entries %d-%d from %s, whose log has entries %d-%d", min, max, target, resp.GetFirstIndex(), resp.GetLastIndex())
+		return nil
+	}
+	if !*assumeYes {
+		if err := confirm(fmt.Sprintf("About to delete log entries %d-%d from %s, whose log has entries %d-%d.\nThis can't be undone, and deleting committed entries loses data.", min, max, target, resp.GetFirstIndex(), resp.GetLastIndex())); err != nil {
+			return err
+		}
+	}
+	log.Printf("Invoking DeleteLogRange(%d-%d)", min, max)
+	resp, err = c.DeleteLogRange(ctx, &pb.DeleteLogRangeRequest{MinIndex: min, MaxIndex: max, ConfirmationToken: resp.GetConfirmationToken()})
+	if err != nil {
+		return err
+	}
+	printResponse(resp)
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// logs dumps raft log entries in a range, and optionally keeps following new ones.
+func logs(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("logs", flag.ContinueOnError)
+	data := fs.String("data", "none", "How to print the data of the entries: none, hex or base64")
+	tail := fs.Bool("tail", false, "Keep following new entries")
+	interval := fs.Duration("interval", time.Second, "How often to poll for new entries with --tail")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	usage := fmt.Errorf("Usage: raftadmin <host:port> logs [--data=none|hex|base64] [--tail] [<start_index> [<end_index>]]")
+	if fs.NArg() > 2 {
+		return usage
+	}
+	switch *data {
+	case "none", "hex", "base64":
+	default:
+		return usage
+	}
+	var start, end uint64
+	if fs.NArg() >= 1 {
+		var err error
+		start, err = strconv.ParseUint(fs.Arg(0), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+	if fs.NArg() >= 2 {
+		var err error
+		end, err = strconv.ParseUint(fs.Arg(1), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewRaftAdminClient(conn)
+
+	if *tail && fs.NArg() == 0 {
+		// Like tail(1), start with the last 10 entries.
+		resp, err := c.LastIndex(ctx, &pb.LastIndexRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.GetIndex() > 10 {
+			start = resp.GetIndex() - 9
+		}
+	}
+
+	for {
+		resp, err := c.GetLogs(ctx, &pb.GetLogsRequest{
+			StartIndex:  start,
+			EndIndex:    end,
+			IncludeData: *data != "none",
+		})
+		if err != nil {
+			return err
+		}
+		for _, l := range resp.GetLogs() {
+			if err := printLog(l, *data); err != nil {
+				return err
+			}
+			start = l.GetIndex() + 1
+		}
+		if resp.GetNextIndex() != 0 {
+			// The server returned a page; fetch the rest right away.
+			start = resp.GetNextIndex()
+			continue
+		}
+		if !*tail || (end != 0 && start > end) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(*interval):
+		}
+	}
+}
+
+func printLog(l *pb.GetLogsResponse_Log, data string) error {
+	if *output == "json" {
+		b, err := protojson.Marshal(l)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+	s := fmt.Sprintf("index=%d term=%d type=%s length=%d", l.GetIndex(), l.GetTerm(), l.GetType(), l.GetDataLength())
+	if l.GetAppendedAtUnixNano() != 0 {
+		s += " appended_at=" + time.Unix(0, l.GetAppendedAtUnixNano()).Format(time.RFC3339Nano)
+	}
+	switch data {
+	case "hex":
+		s += " data=" + hex.EncodeToString(l.GetData())
+	case "base64":
+		s += " data=" + base64.StdEncoding.EncodeToString(l.GetData())
+	}
+	fmt.Println(s)
+	return nil
+}
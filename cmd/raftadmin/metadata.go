@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// setMetadata sets tags on a node, given as key=value. An empty value removes the key.
+func setMetadata(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("set_metadata", flag.ContinueOnError)
+	replace := fs.Bool("replace", false, "Remove all existing tags first")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 && !*replace {
+		return fmt.Errorf("Usage: raftadmin <host:port> set_metadata [--replace] <key>=<value> ...")
+	}
+	req := &pb.SetMetadataRequest{
+		Metadata: map[string]string{},
+		Replace:  *replace,
+	}
+	for _, kv := range fs.Args() {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" {
+			return fmt.Errorf("invalid tag %q, expected <key>=<value>", kv)
+		}
+		req.Metadata[k] = v
+	}
+	if *dryRun {
+		log.Printf("Dry run: would invoke SetMetadata(%s) on %s", strings.TrimSpace(prototext.Format(req)), target)
+		return nil
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	log.Printf("Invoking SetMetadata(%s)", prototext.Format(req))
+	resp, err := pb.NewRaftAdminClient(conn).SetMetadata(ctx, req)
+	if err != nil {
+		return err
+	}
+	printResponse(resp)
+	return nil
+}
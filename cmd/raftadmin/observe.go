@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// observe prints raft observations (like leadership changes, peer changes and failed heartbeats) as they happen.
+func observe(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("observe", flag.ContinueOnError)
+	types := fs.String("types", "", "Comma separated list of observations to show: leader, peer, failed_heartbeat, resumed_heartbeat, request_vote and state. Defaults to all")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> observe [--types=leader,peer,...]")
+	}
+	req := &pb.ObserveRequest{}
+	for _, t := range strings.Split(*types, ",") {
+		if t == "" {
+			continue
+		}
+		v, ok := pb.ObserveRequest_Type_value[strings.ToUpper(t)]
+		if !ok {
+			return fmt.Errorf("unknown observation type %q", t)
+		}
+		req.Types = append(req.Types, pb.ObserveRequest_Type(v))
+	}
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := pb.NewRaftAdminClient(conn).Observe(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		o, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if *output == "json" {
+			b, err := protojson.Marshal(o)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			continue
+		}
+		fmt.Printf("%s %s\n", time.Unix(0, o.GetUnixNano()).Format(time.RFC3339Nano), describeObservation(o))
+	}
+}
+
+// watchAppliedIndex prints the applied index of the node whenever it changes.
+func watchAppliedIndex(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("watch_applied_index", flag.ContinueOnError)
+	minInterval := fs.Duration("min-interval", 0, "Print at most one update per interval")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> watch_applied_index [--min-interval=1s]")
+	}
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := pb.NewRaftAdminClient(conn).WatchAppliedIndex(ctx, &pb.WatchAppliedIndexRequest{MinIntervalMs: uint64(*minInterval / time.Millisecond)})
+	if err != nil {
+		return err
+	}
+	for {
+		a, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if *output == "json" {
+			b, err := protojson.Marshal(a)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			continue
+		}
+		fmt.Printf("%s %d\n", time.Now().Format(time.RFC3339Nano), a.GetIndex())
+	}
+}
+
+// watchConfiguration prints the latest configuration known by the node, and then every change of it.
+func watchConfiguration(ctx context.Context, target string, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> watch_configuration")
+	}
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := pb.NewRaftAdminClient(conn).WatchConfiguration(ctx, &pb.WatchConfigurationRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		c, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if *output == "json" {
+			b, err := protojson.Marshal(c)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			continue
+		}
+		desc := "Configuration"
+		if c.GetIndex() != 0 {
+			desc = fmt.Sprintf("Configuration at index %d", c.GetIndex())
+		}
+		fmt.Printf("%s %s:\n%s\n", time.Now().Format(time.RFC3339Nano), desc, formatServers(c.GetServers()))
+	}
+}
+
+// watchLeader prints the leader as known by the node, and then every change of it.
+func watchLeader(ctx context.Context, target string, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> watch_leader")
+	}
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := pb.NewRaftAdminClient(conn).WatchLeader(ctx, &pb.WatchLeaderRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		l, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if *output == "json" {
+			b, err := protojson.Marshal(l)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			continue
+		}
+		if l.GetAddress() == "" {
+			fmt.Printf("%s No leader\n", time.Now().Format(time.RFC3339Nano))
+			continue
+		}
+		fmt.Printf("%s %s (%s)\n", time.Now().Format(time.RFC3339Nano), l.GetId(), l.GetAddress())
+	}
+}
+
+// watchState prints the state of the node, and then every change of it.
+func watchState(ctx context.Context, target string, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> watch_state")
+	}
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := pb.NewRaftAdminClient(conn).WatchState(ctx, &pb.WatchStateRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		s, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if *output == "json" {
+			b, err := protojson.Marshal(s)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			continue
+		}
+		fmt.Printf("%s %s\n", time.Now().Format(time.RFC3339Nano), s.GetState())
+	}
+}
+
+func describeObservation(o *pb.Observation) string {
+	switch d := o.GetObservation().(type) {
+	case *pb.Observation_Leader_:
+		if d.Leader.GetAddress() == "" {
+			return "Leader lost"
+		}
+		return fmt.Sprintf("Leader changed to %s (%s)", d.Leader.GetId(), d.Leader.GetAddress())
+	case *pb.Observation_Peer_:
+		if d.Peer.GetRemoved() {
+			return fmt.Sprintf("Peer %s (%s) removed", d.Peer.GetId(), d.Peer.GetAddress())
+		}
+		return fmt.Sprintf("Peer %s (%s) updated to %s", d.Peer.GetId(), d.Peer.GetAddress(), d.Peer.GetSuffrage())
+	case *pb.Observation_FailedHeartbeat_:
+		return fmt.Sprintf("Failed heartbeat to %s, last contact at %s", d.FailedHeartbeat.GetId(), time.Unix(0, d.FailedHeartbeat.GetLastContactUnixNano()).Format(time.RFC3339Nano))
+	case *pb.Observation_ResumedHeartbeat_:
+		return fmt.Sprintf("Resumed heartbeat to %s", d.ResumedHeartbeat.GetId())
+	case *pb.Observation_RequestVote_:
+		v := d.RequestVote
+		s := fmt.Sprintf("Vote requested by %s (%s) for term %d, last log index %d term %d", v.GetCandidateId(), v.GetCandidateAddress(), v.GetTerm(), v.GetLastLogIndex(), v.GetLastLogTerm())
+		if v.GetLeadershipTransfer() {
+			s += " (leadership transfer)"
+		}
+		return s
+	case *pb.Observation_State:
+		return fmt.Sprintf("State changed to %s", d.State)
+	default:
+		return fmt.Sprintf("Unknown observation: %v", o)
+	}
+}
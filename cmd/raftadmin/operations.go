@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// listOperations shows the RPCs a node handled recently, as recorded with raftadmin.WithOperationsLog.
+func listOperations(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("list_operations", flag.ContinueOnError)
+	method := fs.String("method", "", "Only show calls to this method, like RemoveServer")
+	limit := fs.Uint64("limit", 0, "Show at most this many operations (0 for all)")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> list_operations [--method=RemoveServer] [--limit=N]")
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := pb.NewRaftAdminClient(conn).ListOperations(ctx, &pb.ListOperationsRequest{Method: *method, Limit: *limit})
+	if err != nil {
+		return err
+	}
+	if *output == "json" {
+		fmt.Println(protojson.Format(resp))
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tMETHOD\tCALLER\tRESULT\tDURATION\tREQUEST")
+	for _, op := range resp.GetOperations() {
+		result := op.GetCode()
+		if op.GetError() != "" {
+			result += ": " + op.GetError()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", time.Unix(0, op.GetStartUnixNano()).Format(time.RFC3339), op.GetMethod(), op.GetCaller(), result, time.Duration(op.GetDurationNanos()), op.GetRequest())
+	}
+	return tw.Flush()
+}
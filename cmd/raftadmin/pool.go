@@ -0,0 +1,49 @@
+package main
+
+import (
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// connPool keeps one connection per endpoint, so commands that talk to the same nodes repeatedly (fanout, --watch, top, wait-stable) don't dial every time.
+type connPool struct {
+	mtx   sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+var pool = &connPool{conns: map[string]*grpc.ClientConn{}}
+
+// get returns the connection to endpoint, dialing it if needed. Connections that failed or were shut down are replaced by a new one.
+// It doesn't block on connecting, so unreachable nodes fail fast when the connection is used.
+func (p *connPool) get(endpoint string) (*grpc.ClientConn, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if c, ok := p.conns[endpoint]; ok {
+		switch c.GetState() {
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			c.Close()
+			delete(p.conns, endpoint)
+		default:
+			return c, nil
+		}
+	}
+	c, err := grpc.Dial(endpoint, append(append([]grpc.DialOption{grpc.WithInsecure()}, authDialOptions()...), debugDialOptions()...)...)
+	if err != nil {
+		return nil, err
+	}
+	logConnectivity(c)
+	p.conns[endpoint] = c
+	return c, nil
+}
+
+// closeAll closes all connections in the pool.
+func (p *connPool) closeAll() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for e, c := range p.conns {
+		c.Close()
+		delete(p.conns, e)
+	}
+}
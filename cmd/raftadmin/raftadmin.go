@@ -3,18 +3,28 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Jille/raftadmin"
 	pb "github.com/Jille/raftadmin/proto"
 	"github.com/iancoleman/strcase"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 
 	// Allow dialing multiple nodes with multi:///.
 	_ "github.com/Jille/grpc-multi-resolver"
@@ -23,81 +33,325 @@ import (
 	_ "google.golang.org/grpc/health"
 )
 
+var (
+	dialTimeout        = flag.Duration("dial-timeout", 10*time.Second, "How long to wait for a connection to the target")
+	leader             = flag.Bool("leader", false, "Whether to dial to the leader (requires https://github.com/Jille/raft-grpc-leader-rpc)")
+	healthCheckService = flag.String("health_check_service", raftadmin.LeaderHealthService, "Which gRPC service to health check when searching for the leader")
+	all                = flag.Bool("all", false, "Send a read-only or maintenance command to every endpoint in the target list in parallel")
+	watch              = flag.Duration("watch", 0, "Repeat a read-only command on this interval and highlight what changed")
+	output             = flag.String("output", "text", "Output format of responses: text or json")
+	assumeYes          = flag.Bool("yes", false, "Don't ask for confirmation before destructive commands")
+	noAwait            = flag.Bool("no-await", false, "Don't wait for futures; print their token so they can be collected later with await")
+	useReflection      = flag.Bool("reflection", false, "Fetch the RaftAdmin service definition from the server's gRPC reflection service instead of using the compiled-in one")
+	grpcDebug          = flag.Bool("grpc-debug", false, "Log gRPC's resolver, balancer, health check and connectivity decisions and where each RPC was sent")
+	dryRun             = flag.Bool("dry-run", false, "Print the requests that would be sent, but don't send any that change state")
+	force              = flag.Bool("force", false, "Set the force field of the request, like to remove_server the leader")
+	authToken          = flag.String("auth-token", "", "Bearer token to send with every call, for servers using raftadmin.WithAuthToken (default $RAFTADMIN_AUTH_TOKEN)")
+	clusterFlag        = flag.String("cluster", "", "ID of the raft group to send calls to, for servers that host several with raftadmin.Clusters (default $RAFTADMIN_CLUSTER)")
+)
+
 func main() {
 	if err := do(); err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		var ee exitError
+		if errors.As(err, &ee) {
+			os.Exit(ee.code)
+		}
+		os.Exit(1)
+	}
+}
+
+// exitError is an error that should make the process exit with a specific code.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e exitError) Error() string {
+	return e.err.Error()
+}
+
+func (e exitError) Unwrap() error {
+	return e.err
+}
+
+// readOnlyMethods are the unary methods that don't change any state on the server: those of the RaftInspect service, which servers with raftadmin.WithReadOnly allow.
+var readOnlyMethods = func() map[protoreflect.Name]bool {
+	ret := map[protoreflect.Name]bool{}
+	methods := pb.File_raftadmin_proto.Services().ByName("RaftInspect").Methods()
+	for i := 0; methods.Len() > i; i++ {
+		if m := methods.Get(i); !m.IsStreamingClient() && !m.IsStreamingServer() {
+			ret[m.Name()] = true
+		}
 	}
+	return ret
+}()
+
+// perNodeMethods change state that only exists on the node they're sent to, so they can be sent to every node with --all.
+var perNodeMethods = map[protoreflect.Name]bool{
+	"EnterMaintenance": true,
+	"ExitMaintenance":  true,
+}
+
+// destructiveMethods are the methods that ask for confirmation before being sent, unless --yes is given.
+var destructiveMethods = map[protoreflect.Name]bool{
+	"ApplyLog":         true,
+	"GracefulShutdown": true,
+	"RemoveServer":     true,
+	"Shutdown":         true,
 }
 
-// There is no way to go from a protoreflect.MessageDescriptor to an instance of the message :(
-var protoTypes = []protoreflect.ProtoMessage{
-	&pb.Future{},
-	&pb.AwaitResponse{},
-	&pb.ForgetResponse{},
-	&pb.AddVoterRequest{},
-	&pb.AddNonvoterRequest{},
-	&pb.ApplyLogRequest{},
-	&pb.AppliedIndexRequest{},
-	&pb.AppliedIndexResponse{},
-	&pb.BarrierRequest{},
-	&pb.DemoteVoterRequest{},
-	&pb.GetConfigurationRequest{},
-	&pb.GetConfigurationResponse{},
-	&pb.LastContactRequest{},
-	&pb.LastContactResponse{},
-	&pb.LastIndexRequest{},
-	&pb.LastIndexResponse{},
-	&pb.LeaderRequest{},
-	&pb.LeaderResponse{},
-	&pb.LeadershipTransferRequest{},
-	&pb.LeadershipTransferToServerRequest{},
-	&pb.RemoveServerRequest{},
-	&pb.ShutdownRequest{},
-	&pb.SnapshotRequest{},
-	&pb.StateRequest{},
-	&pb.StateResponse{},
-	&pb.StatsRequest{},
-	&pb.StatsResponse{},
-	&pb.VerifyLeaderRequest{},
+// customCommands are commands that are implemented by the CLI rather than mapping onto a single RPC.
+var customCommands = map[string]func(ctx context.Context, target string, args []string) error{
+	"apply_batch":         applyBatch,
+	"apply_stream":        applyStream,
+	"await":               awaitCommand,
+	"bootstrap_cluster":   bootstrapCluster,
+	"check-state":         checkState,
+	"delete_log_range":    deleteLogRange,
+	"export":              export,
+	"list_operations":     listOperations,
+	"logs":                logs,
+	"observe":             observe,
+	"reload_config":       reloadConfig,
+	"set_metadata":        setMetadata,
+	"snapshot download":   snapshotDownload,
+	"snapshot list":       snapshotList,
+	"snapshot restore":    snapshotRestore,
+	"stage_recovery":      stageRecovery,
+	"stats_history":       statsHistory,
+	"top":                 top,
+	"transfer-leadership": transferLeadership,
+	"verify":              verify,
+	"verify_fsm":          verifyFSM,
+	"version":             version,
+	"wait-stable":         waitStable,
+	"watch_applied_index": watchAppliedIndex,
+	"watch_configuration": watchConfiguration,
+	"watch_leader":        watchLeader,
+	"watch_state":         watchState,
 }
 
 // messageFromDescriptor creates a new Message for a MessageDescriptor.
+// It uses the generated type if d comes from the compiled-in proto, so callers can type assert on it, and falls back to a dynamic message otherwise (e.g. for descriptors from --reflection).
 func messageFromDescriptor(d protoreflect.MessageDescriptor) protoreflect.Message {
-	for _, m := range protoTypes {
-		if m.ProtoReflect().Descriptor() == d {
-			return m.ProtoReflect().New()
-		}
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(d.FullName()); err == nil && mt.Descriptor() == d {
+		return mt.New()
 	}
-	panic(fmt.Errorf("unknown type %q; please add it to protoTypes", d.FullName()))
+	return dynamicpb.NewMessage(d)
 }
 
 func do() error {
 	ctx := context.Background()
+	defer pool.closeAll()
 	methods := pb.File_raftadmin_proto.Services().ByName("RaftAdmin").Methods()
-	leader := flag.Bool("leader", false, "Whether to dial to the leader (requires https://github.com/Jille/raft-grpc-leader-rpc)")
-	healthCheckService := flag.String("health_check_service", "quis.RaftLeader", "Which gRPC service to health check when searching for the leader")
-	flag.Parse()
+	// Global flags may appear anywhere. Unknown flags are left for the command.
+	globalFlags, args := extractFlags(flag.CommandLine, os.Args[1:])
+	if err := flag.CommandLine.Parse(globalFlags); err != nil {
+		return err
+	}
+	if *grpcDebug {
+		enableGRPCDebug()
+	}
 
-	if flag.NArg() < 2 {
+	if len(args) < 2 {
 		var commands []string
 		for i := 0; methods.Len() > i; i++ {
-			commands = append(commands, strcase.ToSnake(string(methods.Get(i).Name())))
+			if m := methods.Get(i); !m.IsStreamingServer() && !m.IsStreamingClient() && customCommands[strcase.ToSnake(string(m.Name()))] == nil {
+				commands = append(commands, strcase.ToSnake(string(m.Name())))
+			}
+		}
+		for c := range customCommands {
+			commands = append(commands, c)
 		}
 		sort.Strings(commands)
 		return fmt.Errorf("Usage: raftadmin <host:port> <command> <args...>\nCommands: %s", strings.Join(commands, ", "))
 	}
 
-	target := flag.Arg(0)
-	command := flag.Arg(1)
+	target := args[0]
+	command := args[1]
+	if len(args) >= 3 {
+		if c, ok := customCommands[command+" "+args[2]]; ok {
+			return c(ctx, target, args[3:])
+		}
+	}
+	if c, ok := customCommands[command]; ok {
+		return c(ctx, target, args[2:])
+	}
+	if *useReflection {
+		conn, err := dial(target)
+		if err != nil {
+			return err
+		}
+		sd, err := serviceFromReflection(ctx, conn, "RaftAdmin")
+		conn.Close()
+		if err != nil {
+			return err
+		}
+		methods = sd.Methods()
+	}
 	// Look up the command as CamelCase and as-is (usually snake_case).
 	m := methods.ByName(protoreflect.Name(command))
 	if m == nil {
 		m = methods.ByName(protoreflect.Name(strcase.ToCamel(command)))
 	}
+	if m == nil {
+		// It might be an extension of the application, which we can only learn about through reflection.
+		m = extensionFromReflection(ctx, target, command)
+	}
 	if m == nil {
 		return fmt.Errorf("unknown command %q", command)
 	}
 
+	reqArgs := args[2:]
+	if len(reqArgs) > 0 && reqArgs[0] == "--" {
+		reqArgs = reqArgs[1:]
+	}
+	req, err := buildRequest(command, m, reqArgs)
+	if err != nil {
+		return err
+	}
+	if *force {
+		f := m.Input().Fields().ByName("force")
+		if f == nil {
+			return fmt.Errorf("--force doesn't apply to %s", command)
+		}
+		req.Set(f, protoreflect.ValueOfBool(true))
+	}
+
+	if *all && !isReadOnly(m) && !perNodeMethods[m.Name()] {
+		return fmt.Errorf("--all only works with read-only commands and maintenance mode, and %s is neither", command)
+	}
+	if *watch > 0 && !isReadOnly(m) {
+		return fmt.Errorf("--watch only works with read-only commands, and %s is not one of them", command)
+	}
+	if *output != "text" && *output != "json" {
+		return fmt.Errorf("unknown --output format %q", *output)
+	}
+	if *dryRun && !isReadOnly(m) {
+		log.Printf("Dry run: would invoke %s(%s) on %s", m.Name(), strings.TrimSpace(prototext.Format(req.Interface())), target)
+		return nil
+	}
+	title := strings.Join(args, " ")
+	if *all {
+		if *watch > 0 {
+			return watchLoop(ctx, *watch, title, fanoutSampler(splitTargets(target), m, req))
+		}
+		return fanout(ctx, splitTargets(target), m, req)
+	}
+
+	// Connect and send the RPC.
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if *watch > 0 {
+		return watchLoop(ctx, *watch, title, connSampler(conn, m, req))
+	}
+
+	if err := confirmDestructive(ctx, conn, target, m, req); err != nil {
+		return err
+	}
+
+	log.Printf("Invoking %s(%s)", m.Name(), prototext.Format(req.Interface()))
+	resp, err := invoke(ctx, conn, m, req)
+	if id, addr, ok := pb.LeaderFromError(err); ok && addr != "" && !*leader {
+		// The server told us who the leader is. Nothing happened on this node, so it's safe to try again there.
+		log.Printf("%s isn't the leader; redirecting to the leader %s at %s", target, id, addr)
+		target = addr
+		conn, err = dial(target)
+		if err != nil {
+			// leader_address is the raft transport address, which isn't necessarily where the leader serves RaftAdmin.
+			return fmt.Errorf("redirecting to the leader's raft address %s failed (use --leader or send the command to the leader yourself): %v", addr, err)
+		}
+		defer conn.Close()
+		if err := confirmDestructive(ctx, conn, target, m, req); err != nil {
+			return err
+		}
+		log.Printf("Invoking %s(%s)", m.Name(), prototext.Format(req.Interface()))
+		resp, err = invoke(ctx, conn, m, req)
+		if err != nil && status.Code(err) == codes.Unavailable {
+			log.Printf("The leader's raft address %s didn't answer; it might not serve RaftAdmin there", addr)
+		}
+	}
+	if token, effect, ok := pb.ConfirmationFromError(err); ok {
+		// The server did nothing yet, and told us what the call would do.
+		if !*assumeYes {
+			if err := confirm(fmt.Sprintf("%s on %s %s.", m.Name(), target, effect)); err != nil {
+				return err
+			}
+		}
+		req.Set(m.Input().Fields().ByName("confirmation_token"), protoreflect.ValueOfString(token))
+		log.Printf("Invoking %s(%s)", m.Name(), prototext.Format(req.Interface()))
+		resp, err = invoke(ctx, conn, m, req)
+	}
+	if err != nil {
+		return err
+	}
+	printResponse(resp.Interface())
+
+	// This method returned a future. We should call Await to get the result, and then Forget to free up the memory of the server.
+	if f, ok := asFuture(resp); ok {
+		for _, w := range f.GetWarnings() {
+			log.Printf("Warning: %s", w)
+		}
+		if *noAwait {
+			log.Printf("Not waiting for the operation. Collect its result later with:\n  raftadmin %s await %s", target, f.GetOperationToken())
+			return nil
+		}
+		return awaitFuture(ctx, conn, target, f.Future())
+	}
+	return nil
+}
+
+// confirmDestructive asks the user to confirm destructive methods, unless --yes is given.
+// Servers using raftadmin.WithConfirmation describe what the call will do when it's first sent, so for those we ask after that instead.
+func confirmDestructive(ctx context.Context, conn *grpc.ClientConn, target string, m protoreflect.MethodDescriptor, req protoreflect.Message) error {
+	if !destructiveMethods[m.Name()] || *assumeYes {
+		return nil
+	}
+	c := pb.NewRaftAdminClient(conn)
+	if m.Input().Fields().ByName("confirmation_token") != nil {
+		if info, err := c.ServerInfo(ctx, &pb.ServerInfoRequest{}); err == nil && info.GetConfirmationRequired() {
+			return nil
+		}
+	}
+	cfg, err := c.GetConfiguration(ctx, &pb.GetConfigurationRequest{})
+	if err != nil {
+		return err
+	}
+	return confirm(fmt.Sprintf("About to invoke %s(%s) on %s.\nThe current configuration is:\n%s", m.Name(), strings.TrimSpace(prototext.Format(req.Interface())), target, formatServers(cfg.GetServers())))
+}
+
+// dial connects to the target, or to the leader if --leader is given. It gives up after --dial-timeout.
+func dial(target string) (*grpc.ClientConn, error) {
+	var o grpc.DialOption = grpc.EmptyDialOption{}
+	if *leader {
+		o = grpc.WithDefaultServiceConfig(raftadmin.LeaderServiceConfig(*healthCheckService))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *dialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, target, append(append([]grpc.DialOption{grpc.WithInsecure(), grpc.WithBlock(), o}, authDialOptions()...), debugDialOptions()...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s within %s: %v", target, *dialTimeout, err)
+	}
+	logConnectivity(conn)
+	return conn, nil
+}
+
+// printResponse logs the response, or prints it to stdout with --output=json.
+func printResponse(resp proto.Message) {
+	if *output == "json" {
+		fmt.Println(protojson.Format(resp))
+		return
+	}
+	log.Printf("Response: %s", prototext.Format(resp))
+}
+
+// buildRequest converts the given strings to the right type and sets them on a new request proto for m.
+func buildRequest(command string, m protoreflect.MethodDescriptor, args []string) (protoreflect.Message, error) {
 	// Sort fields by field number.
 	reqDesc := m.Input()
 	unorderedFields := reqDesc.Fields()
@@ -106,66 +360,75 @@ func do() error {
 		f := unorderedFields.Get(i)
 		fields[f.Number()-1] = f
 	}
-	if flag.NArg() != 2+len(fields) {
+	// Trailing fields can be left out, so adding fields to a request doesn't break existing invocations.
+	if len(args) > len(fields) {
 		var names []string
 		for _, f := range fields {
 			names = append(names, fmt.Sprintf("<%s>", f.TextName()))
 		}
-		return fmt.Errorf("Usage: raftadmin <host:port> %s %s", command, strings.Join(names, " "))
+		return nil, fmt.Errorf("Usage: raftadmin <host:port> %s %s", command, strings.Join(names, " "))
 	}
 
-	// Convert given strings to the right type and set them on the request proto.
 	req := messageFromDescriptor(reqDesc)
-	for i, f := range fields {
-		s := flag.Arg(2 + i)
+	for i, s := range args {
+		f := fields[i]
 		var v protoreflect.Value
 		switch f.Kind() {
 		case protoreflect.StringKind:
 			v = protoreflect.ValueOfString(s)
 		case protoreflect.BytesKind:
 			v = protoreflect.ValueOfBytes([]byte(s))
+		case protoreflect.BoolKind:
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, err
+			}
+			v = protoreflect.ValueOfBool(b)
 		case protoreflect.Uint64Kind:
 			i, err := strconv.ParseUint(s, 10, 64)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			v = protoreflect.ValueOfUint64(uint64(i))
 		default:
-			return fmt.Errorf("internal error: kind %s is not yet supported", f.Kind().String())
+			return nil, fmt.Errorf("internal error: kind %s is not yet supported", f.Kind().String())
 		}
 		req.Set(f, v)
 	}
+	return req, nil
+}
 
-	// Connect and send the RPC.
-	var o grpc.DialOption = grpc.EmptyDialOption{}
-	if *leader {
-		o = grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"healthCheckConfig": {"serviceName": "%s"}, "loadBalancingConfig": [ { "round_robin": {} } ]}`, *healthCheckService))
+// asFuture returns resp as a FutureResponse if it is one. Responses built from reflected descriptors are converted, including the Future that older servers return.
+func asFuture(resp protoreflect.Message) (*pb.FutureResponse, bool) {
+	if f, ok := resp.Interface().(*pb.FutureResponse); ok {
+		return f, true
 	}
-	conn, err := grpc.Dial(target, grpc.WithInsecure(), grpc.WithBlock(), o)
+	switch resp.Descriptor().FullName() {
+	case (&pb.FutureResponse{}).ProtoReflect().Descriptor().FullName(), (&pb.Future{}).ProtoReflect().Descriptor().FullName():
+	default:
+		return nil, false
+	}
+	b, err := proto.Marshal(resp.Interface())
 	if err != nil {
-		return err
+		return nil, false
 	}
-	defer conn.Close()
-
-	log.Printf("Invoking %s(%s)", m.Name(), prototext.Format(req.Interface()))
-	resp := messageFromDescriptor(m.Output()).Interface()
-	if err := conn.Invoke(ctx, "/RaftAdmin/"+string(m.Name()), req.Interface(), resp); err != nil {
-		return err
+	f := &pb.FutureResponse{}
+	if err := proto.Unmarshal(b, f); err != nil {
+		return nil, false
 	}
-	log.Printf("Response: %s", prototext.Format(resp))
+	return f, true
+}
 
-	// This method returned a future. We should call Await to get the result, and then Forget to free up the memory of the server.
-	if f, ok := resp.(*pb.Future); ok {
-		c := pb.NewRaftAdminClient(conn)
-		log.Printf("Invoking Await(%s)", prototext.Format(f))
-		resp, err := c.Await(ctx, f)
-		if err != nil {
-			return err
-		}
-		log.Printf("Response: %s", prototext.Format(resp))
-		if _, err := c.Forget(ctx, f); err != nil {
-			return err
-		}
+// invoke sends the RPC for m and returns the response.
+func invoke(ctx context.Context, conn *grpc.ClientConn, m protoreflect.MethodDescriptor, req protoreflect.Message) (protoreflect.Message, error) {
+	resp := messageFromDescriptor(m.Output())
+	err := conn.Invoke(ctx, fmt.Sprintf("/%s/%s", m.Parent().FullName(), m.Name()), req.Interface(), resp.Interface())
+	if status.Code(err) == codes.Unimplemented && m.Parent().FullName() == "RaftAdmin" && pb.File_raftadmin_proto.Services().ByName("RaftInspect").Methods().ByName(m.Name()) != nil {
+		// The server might only serve the read-only methods, in the RaftInspect service.
+		err = conn.Invoke(ctx, fmt.Sprintf("/RaftInspect/%s", m.Name()), req.Interface(), resp.Interface())
 	}
-	return nil
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
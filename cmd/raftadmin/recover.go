@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// stageRecovery asks a node to write a replacement configuration that it will force on itself on the next start.
+func stageRecovery(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("stage_recovery", flag.ContinueOnError)
+	force := fs.Bool("force", false, "Stage the recovery even if the node still knows of a leader")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> stage_recovery [--force] <id>=<address>[=voter|nonvoter] ...")
+	}
+	servers, err := parseServers(fs.Args())
+	if err != nil {
+		return err
+	}
+	req := &pb.StageRecoveryRequest{Servers: servers, Force: *force}
+	if *dryRun {
+		log.Printf("Dry run: would invoke StageRecovery(%s) on %s", strings.TrimSpace(prototext.Format(req)), target)
+		return nil
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewRaftAdminClient(conn)
+	if !*assumeYes {
+		cfg, err := c.GetConfiguration(ctx, &pb.GetConfigurationRequest{})
+		if err != nil {
+			return err
+		}
+		prompt := fmt.Sprintf("About to stage a recovery on %s. When it restarts, it will forget its current configuration:\n%s\nand use this one instead:\n%s\nOnly do this if the cluster lost quorum, and stage the same configuration on every remaining node.", target, formatServers(cfg.GetServers()), formatServers(servers))
+		if err := confirm(prompt); err != nil {
+			return err
+		}
+	}
+	log.Printf("Invoking StageRecovery(%s)", prototext.Format(req))
+	resp, err := c.StageRecovery(ctx, req)
+	if err != nil {
+		return err
+	}
+	printResponse(resp)
+	log.Printf("Restart %s to apply the recovery", target)
+	return nil
+}
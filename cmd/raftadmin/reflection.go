@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// serviceFromReflection fetches the descriptor of a service, like RaftAdmin, from the server's reflection service.
+func serviceFromReflection(ctx context.Context, conn *grpc.ClientConn, name string) (protoreflect.ServiceDescriptor, error) {
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.CloseSend()
+	if err := stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: name},
+	}); err != nil {
+		return nil, err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if e := resp.GetErrorResponse(); e != nil {
+		return nil, fmt.Errorf("reflection failed: %s", e.GetErrorMessage())
+	}
+	// The server sends the file with the service and all its dependencies.
+	var fds descriptorpb.FileDescriptorSet
+	for _, b := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		fd := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(b, fd); err != nil {
+			return nil, err
+		}
+		fds.File = append(fds.File, fd)
+	}
+	files, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse descriptors from reflection: %v", err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is a %T rather than a service", name, d)
+	}
+	return sd, nil
+}
+
+// isReadOnly returns whether m doesn't change any state: either it is one of the readOnlyMethods, or an extension that says it has no side effects.
+func isReadOnly(m protoreflect.MethodDescriptor) bool {
+	if readOnlyMethods[m.Name()] {
+		return true
+	}
+	o, _ := m.Options().(*descriptorpb.MethodOptions)
+	return o.GetIdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+}
+
+// extensionFromReflection looks up command among the extensions the server added with raftadmin.WithExtension. It returns nil if the server has no such extension or doesn't support reflection.
+func extensionFromReflection(ctx context.Context, target, command string) protoreflect.MethodDescriptor {
+	conn, err := dial(target)
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+	sd, err := serviceFromReflection(ctx, conn, pb.ExtensionServiceName)
+	if err != nil {
+		return nil
+	}
+	if m := sd.Methods().ByName(protoreflect.Name(command)); m != nil {
+		return m
+	}
+	return sd.Methods().ByName(protoreflect.Name(strcase.ToCamel(command)))
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// reloadConfig changes the reloadable raft configuration of a node. Settings that aren't given keep their current value.
+func reloadConfig(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("reload_config", flag.ContinueOnError)
+	trailingLogs := fs.Uint64("trailing-logs", 0, "Number of logs to leave after a snapshot")
+	snapshotInterval := fs.Duration("snapshot-interval", 0, "How often to check whether a snapshot should be taken")
+	snapshotThreshold := fs.Uint64("snapshot-threshold", 0, "Number of outstanding logs that triggers a snapshot")
+	heartbeatTimeout := fs.Duration("heartbeat-timeout", 0, "Time in follower state without contact from a leader before becoming a candidate")
+	electionTimeout := fs.Duration("election-timeout", 0, "Time in candidate state without contact from a leader before starting an election")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 || fs.NFlag() == 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port> reload_config [--trailing-logs=N] [--snapshot-interval=D] [--snapshot-threshold=N] [--heartbeat-timeout=D] [--election-timeout=D]")
+	}
+	req := &pb.ReloadConfigRequest{
+		Config: &pb.ReloadableConfig{
+			TrailingLogs:          *trailingLogs,
+			SnapshotIntervalNanos: int64(*snapshotInterval),
+			SnapshotThreshold:     *snapshotThreshold,
+			HeartbeatTimeoutNanos: int64(*heartbeatTimeout),
+			ElectionTimeoutNanos:  int64(*electionTimeout),
+		},
+	}
+	if *dryRun {
+		log.Printf("Dry run: would invoke ReloadConfig(%s) on %s", strings.TrimSpace(prototext.Format(req)), target)
+		return nil
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	log.Printf("Invoking ReloadConfig(%s)", prototext.Format(req))
+	resp, err := pb.NewRaftAdminClient(conn).ReloadConfig(ctx, req)
+	if err != nil {
+		return err
+	}
+	printResponse(resp)
+	return nil
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// snapshotDownload streams a snapshot from a node into a local file, and writes its metadata next to it.
+func snapshotDownload(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("snapshot download", flag.ContinueOnError)
+	id := fs.String("id", "", "ID of the snapshot to download, defaults to the latest")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("Usage: raftadmin <host:port> snapshot download [--id=<id>] <file>")
+	}
+	fn := fs.Arg(0)
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := pb.NewRaftAdminClient(conn).DownloadSnapshot(ctx, &pb.DownloadSnapshotRequest{Id: *id})
+	if err != nil {
+		return err
+	}
+
+	fh, err := os.Create(fn + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fn + ".tmp")
+	defer fh.Close()
+
+	var meta *pb.SnapshotMeta
+	var checksum []byte
+	var written int64
+	h := sha256.New()
+	p := newProgress("Downloaded")
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch m := msg.GetMessage().(type) {
+		case *pb.DownloadSnapshotResponse_Meta:
+			meta = m.Meta
+			log.Printf("Downloading snapshot %s (index %d, term %d, %d bytes)", meta.GetId(), meta.GetIndex(), meta.GetTerm(), meta.GetSize())
+		case *pb.DownloadSnapshotResponse_Data:
+			if _, err := fh.Write(m.Data); err != nil {
+				return err
+			}
+			h.Write(m.Data)
+			written += int64(len(m.Data))
+			p.update(written, meta.GetSize())
+		case *pb.DownloadSnapshotResponse_Sha256:
+			checksum = m.Sha256
+		}
+	}
+	p.done(written, meta.GetSize())
+
+	if meta == nil {
+		return fmt.Errorf("server didn't send the snapshot metadata")
+	}
+	if written != meta.GetSize() {
+		return fmt.Errorf("received %d bytes, but the snapshot is %d bytes", written, meta.GetSize())
+	}
+	if !bytes.Equal(checksum, h.Sum(nil)) {
+		return fmt.Errorf("checksum mismatch: server sent %x, received data has %x", checksum, h.Sum(nil))
+	}
+	if err := fh.Close(); err != nil {
+		return err
+	}
+	mj, err := protojson.MarshalOptions{Multiline: true}.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(fn+".meta.json", mj, 0644); err != nil {
+		return err
+	}
+	// In sha256sum(1) format, so it can also be checked with sha256sum -c.
+	if err := os.WriteFile(fn+".sha256", []byte(fmt.Sprintf("%x  %s\n", checksum, filepath.Base(fn))), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(fn+".tmp", fn); err != nil {
+		return err
+	}
+	log.Printf("Wrote snapshot to %s (sha256 %x) and its metadata to %s.meta.json", fn, checksum, fn)
+	return nil
+}
+
+// snapshotRestore verifies a snapshot written by snapshotDownload and streams it to the leader, which restores it.
+func snapshotRestore(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("snapshot restore", flag.ContinueOnError)
+	yes := fs.Bool("yes", false, "Don't ask for confirmation")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("Usage: raftadmin <host:port> snapshot restore [--yes] <file>")
+	}
+	fn := fs.Arg(0)
+
+	// Verify the snapshot before talking to the cluster.
+	mj, err := os.ReadFile(fn + ".meta.json")
+	if err != nil {
+		return err
+	}
+	var meta pb.SnapshotMeta
+	if err := protojson.Unmarshal(mj, &meta); err != nil {
+		return fmt.Errorf("failed to parse %s.meta.json: %v", fn, err)
+	}
+	fh, err := os.Open(fn)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, fh)
+	if err != nil {
+		return err
+	}
+	if size != meta.GetSize() {
+		return fmt.Errorf("%s is %d bytes, but the metadata says it should be %d bytes", fn, size, meta.GetSize())
+	}
+	if sum, err := os.ReadFile(fn + ".sha256"); err == nil {
+		if want := strings.Fields(string(sum)); len(want) == 0 || want[0] != hex.EncodeToString(h.Sum(nil)) {
+			return fmt.Errorf("checksum mismatch: %s.sha256 doesn't match %x", fn, h.Sum(nil))
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if _, err := fh.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	conn, err := dial(target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewRaftAdminClient(conn)
+	state, err := c.State(ctx, &pb.StateRequest{})
+	if err != nil {
+		return err
+	}
+	if state.GetState() != pb.StateResponse_LEADER {
+		return fmt.Errorf("%s is %s; snapshots can only be restored on the leader (see --leader)", target, state.GetState())
+	}
+
+	if *dryRun {
+		log.Printf("Dry run: would restore snapshot %s (index %d, term %d, %d bytes) on %s", meta.GetId(), meta.GetIndex(), meta.GetTerm(), meta.GetSize(), target)
+		return nil
+	}
+	var token, effect string
+	if info, err := c.ServerInfo(ctx, &pb.ServerInfoRequest{}); err == nil && info.GetConfirmationRequired() {
+		if token, effect, err = restoreConfirmation(ctx, c, &meta); err != nil {
+			return err
+		}
+	}
+	if !*yes && !*assumeYes {
+		cfg, err := c.GetConfiguration(ctx, &pb.GetConfigurationRequest{})
+		if err != nil {
+			return err
+		}
+		prompt := fmt.Sprintf("About to restore snapshot %s (index %d, term %d, %d bytes) on %s.\nThe snapshot was taken with configuration:\n%s\nThe current configuration is:\n%s\nThis replaces the state of the entire cluster.", meta.GetId(), meta.GetIndex(), meta.GetTerm(), meta.GetSize(), target, formatServers(meta.GetConfiguration()), formatServers(cfg.GetServers()))
+		if effect != "" {
+			prompt += fmt.Sprintf("\nRestoreSnapshot on %s %s.", target, effect)
+		}
+		if err := confirm(prompt); err != nil {
+			return err
+		}
+	}
+
+	stream, err := c.RestoreSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.RestoreSnapshotRequest{Message: &pb.RestoreSnapshotRequest_Meta{Meta: &meta}, ConfirmationToken: token}); err != nil {
+		return err
+	}
+	buf := make([]byte, 256*1024)
+	var sent int64
+	p := newProgress("Uploaded")
+	for {
+		n, err := fh.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.RestoreSnapshotRequest{Message: &pb.RestoreSnapshotRequest_Data{Data: buf[:n]}}); err != nil {
+				if err == io.EOF {
+					// The server aborted; CloseAndRecv will return the actual error.
+					break
+				}
+				return err
+			}
+			sent += int64(n)
+			p.update(sent, size)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	p.done(sent, size)
+	if err := stream.Send(&pb.RestoreSnapshotRequest{Message: &pb.RestoreSnapshotRequest_Sha256{Sha256: h.Sum(nil)}}); err != nil && err != io.EOF {
+		return err
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		return err
+	}
+	log.Printf("Restored snapshot %s", fn)
+	return nil
+}
+
+// restoreConfirmation sends just the metadata to a server using raftadmin.WithConfirmation, which refuses it and returns the confirmation token and what the restore would do.
+func restoreConfirmation(ctx context.Context, c pb.RaftAdminClient, meta *pb.SnapshotMeta) (token, effect string, err error) {
+	stream, err := c.RestoreSnapshot(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if err := stream.Send(&pb.RestoreSnapshotRequest{Message: &pb.RestoreSnapshotRequest_Meta{Meta: meta}}); err != nil && err != io.EOF {
+		return "", "", err
+	}
+	_, err = stream.CloseAndRecv()
+	token, effect, ok := pb.ConfirmationFromError(err)
+	if !ok {
+		return "", "", fmt.Errorf("the server didn't return a confirmation token: %v", err)
+	}
+	return token, effect, nil
+}
+
+// snapshotList shows the snapshots stored on each node in the target list.
+func snapshotList(ctx context.Context, target string, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> snapshot list")
+	}
+	endpoints := splitTargets(target)
+	m := pb.File_raftadmin_proto.Services().ByName("RaftAdmin").Methods().ByName("ListSnapshots")
+	failed := 0
+	for i, r := range fanoutInvoke(ctx, endpoints, m, (&pb.ListSnapshotsRequest{}).ProtoReflect()) {
+		if r.err != nil {
+			failed++
+			fmt.Printf("%s: Error: %v\n\n", endpoints[i], r.err)
+			continue
+		}
+		resp := r.resp.Interface().(*pb.ListSnapshotsResponse)
+		if *output == "json" {
+			fmt.Printf("%s: %s\n", endpoints[i], protojson.Format(resp))
+			continue
+		}
+		fmt.Printf("%s:\n", endpoints[i])
+		tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tINDEX\tTERM\tSIZE\tCREATED\tAGE\tCONFIGURATION")
+		for _, s := range resp.GetSnapshots() {
+			created, age := "unknown", "unknown"
+			if s.GetCreatedUnixNano() != 0 {
+				t := time.Unix(0, s.GetCreatedUnixNano())
+				created = t.Format(time.RFC3339)
+				age = time.Since(t).Round(time.Second).String()
+			}
+			var cfg []string
+			for _, srv := range s.GetConfiguration() {
+				cfg = append(cfg, fmt.Sprintf("%s=%s", srv.GetId(), srv.GetSuffrage()))
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%s\t%s\n", s.GetId(), s.GetIndex(), s.GetTerm(), s.GetSize(), created, age, strings.Join(cfg, ","))
+		}
+		tw.Flush()
+		fmt.Println()
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d endpoints failed", failed, len(endpoints))
+	}
+	return nil
+}
+
+func formatServers(servers []*pb.GetConfigurationResponse_Server) string {
+	var sb strings.Builder
+	for _, s := range servers {
+		fmt.Fprintf(&sb, "  %s %s %s\n", s.GetId(), s.GetAddress(), s.GetSuffrage())
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// progress prints the progress of a transfer to stderr, at most a few times per second.
+type progress struct {
+	verb string
+	last time.Time
+}
+
+func newProgress(verb string) *progress {
+	return &progress{verb: verb}
+}
+
+func (p *progress) update(n, total int64) {
+	if n == total || time.Since(p.last) < 200*time.Millisecond {
+		return
+	}
+	p.last = time.Now()
+	p.print(n, total)
+}
+
+func (p *progress) done(n, total int64) {
+	p.print(n, total)
+	fmt.Fprintln(os.Stderr)
+}
+
+func (p *progress) print(n, total int64) {
+	if total > 0 {
+		fmt.Fprintf(os.Stderr, "\r%s %d of %d bytes (%d%%)", p.verb, n, total, n*100/total)
+	} else {
+		fmt.Fprintf(os.Stderr, "\r%s %d bytes", p.verb, n)
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+type topSample struct {
+	stats map[string]string
+	err   error
+}
+
+// top shows a continuously updating overview of all nodes in the target list.
+func top(ctx context.Context, target string, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> top")
+	}
+	interval := *watch
+	if interval <= 0 {
+		interval = time.Second
+	}
+	endpoints := splitTargets(target)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		// Get the connections from the pool every time, so broken ones are replaced.
+		clients := make([]pb.RaftAdminClient, len(endpoints))
+		for i, e := range endpoints {
+			conn, err := pool.get(e)
+			if err != nil {
+				return err
+			}
+			clients[i] = pb.NewRaftAdminClient(conn)
+		}
+		samples := make([]topSample, len(endpoints))
+		var configuration *pb.GetConfigurationResponse
+		var wg sync.WaitGroup
+		for i, c := range clients {
+			wg.Add(1)
+			go func(i int, c pb.RaftAdminClient) {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(ctx, interval)
+				defer cancel()
+				resp, err := c.Stats(ctx, &pb.StatsRequest{})
+				if err != nil {
+					samples[i].err = err
+					return
+				}
+				samples[i].stats = resp.GetStats()
+			}(i, c)
+		}
+		wg.Wait()
+		for i, c := range clients {
+			if samples[i].err != nil {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(ctx, interval)
+			resp, err := c.GetConfiguration(ctx, &pb.GetConfigurationRequest{})
+			cancel()
+			if err == nil {
+				configuration = resp
+				break
+			}
+		}
+
+		fmt.Print("\033[H\033[2J")
+		fmt.Print(renderTop(endpoints, samples, configuration))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// renderTop formats the samples as a table.
+func renderTop(endpoints []string, samples []topSample, configuration *pb.GetConfigurationResponse) string {
+	// Lag is relative to the highest index any node has in its log, which is usually the leader's.
+	var maxIndex uint64
+	for _, s := range samples {
+		if n, err := strconv.ParseUint(s.stats["last_log_index"], 10, 64); err == nil && n > maxIndex {
+			maxIndex = n
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "raftadmin top - %s\n\n", time.Now().Format(time.RFC3339))
+	tw := tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NODE\tSTATE\tTERM\tLAST INDEX\tCOMMIT INDEX\tAPPLIED INDEX\tLAG\tLAST CONTACT")
+	for i, e := range endpoints {
+		s := samples[i]
+		if s.err != nil {
+			fmt.Fprintf(tw, "%s\tunreachable: %v\n", e, s.err)
+			continue
+		}
+		lag := "?"
+		if n, err := strconv.ParseUint(s.stats["applied_index"], 10, 64); err == nil && maxIndex >= n {
+			lag = strconv.FormatUint(maxIndex-n, 10)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e, s.stats["state"], s.stats["term"], s.stats["last_log_index"], s.stats["commit_index"], s.stats["applied_index"], lag, s.stats["last_contact"])
+	}
+	tw.Flush()
+
+	if configuration != nil {
+		fmt.Fprintf(&sb, "\n")
+		tw := tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tADDRESS\tSUFFRAGE")
+		for _, s := range configuration.GetServers() {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", s.GetId(), s.GetAddress(), s.GetSuffrage())
+		}
+		tw.Flush()
+	}
+	return sb.String()
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+type transferCandidate struct {
+	server      *pb.GetConfigurationResponse_Server
+	applied     uint64
+	lastContact time.Duration
+}
+
+// transferLeadership hands over leadership to the most caught-up healthy follower and waits until it has taken over.
+func transferLeadership(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("transfer-leadership", flag.ContinueOnError)
+	toBest := fs.Bool("to-best", false, "Pick the healthiest, most caught-up voter as the new leader")
+	timeout := fs.Duration("timeout", 30*time.Second, "How long to wait for the new leader to take over")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 || !*toBest {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> transfer-leadership --to-best [--timeout=30s]\nUse leadership_transfer_to_server to pick the new leader yourself.")
+	}
+
+	endpoints := splitTargets(target)
+	clients := map[string]pb.RaftAdminClient{}
+	stats := map[string]map[string]string{}
+	var leaderEndpoint string
+	for _, e := range endpoints {
+		conn, err := pool.get(e)
+		if err != nil {
+			return err
+		}
+		c := pb.NewRaftAdminClient(conn)
+		resp, err := c.Stats(ctx, &pb.StatsRequest{})
+		if err != nil {
+			log.Printf("%s: Error: %v", e, err)
+			continue
+		}
+		clients[e] = c
+		stats[e] = resp.GetStats()
+		if stats[e]["state"] == "Leader" {
+			leaderEndpoint = e
+		}
+	}
+	if leaderEndpoint == "" {
+		return fmt.Errorf("none of the %d endpoints is the leader", len(endpoints))
+	}
+	leader := clients[leaderEndpoint]
+	configuration, err := leader.GetConfiguration(ctx, &pb.GetConfigurationRequest{})
+	if err != nil {
+		return err
+	}
+
+	// Nodes are matched to endpoints by their raft address, which is usually the same as their gRPC address.
+	var best *transferCandidate
+	for _, s := range configuration.GetServers() {
+		if s.GetSuffrage() != pb.GetConfigurationResponse_Server_VOTER || s.GetAddress() == leaderEndpoint {
+			continue
+		}
+		st, ok := stats[s.GetAddress()]
+		if !ok {
+			log.Printf("Skipping %s: not reachable or not in the target list", s.GetId())
+			continue
+		}
+		applied, err := strconv.ParseUint(st["applied_index"], 10, 64)
+		if err != nil {
+			log.Printf("Skipping %s: %v", s.GetId(), err)
+			continue
+		}
+		lastContact, err := time.ParseDuration(st["last_contact"])
+		if err != nil {
+			log.Printf("Skipping %s: hasn't heard from the leader (last_contact is %q)", s.GetId(), st["last_contact"])
+			continue
+		}
+		c := &transferCandidate{s, applied, lastContact}
+		if best == nil || c.applied > best.applied || (c.applied == best.applied && c.lastContact < best.lastContact) {
+			best = c
+		}
+	}
+	if best == nil {
+		return fmt.Errorf("no suitable follower found to transfer leadership to")
+	}
+	if *dryRun {
+		log.Printf("Dry run: would transfer leadership to %s (%s) with applied index %d and last contact %s", best.server.GetId(), best.server.GetAddress(), best.applied, best.lastContact)
+		return nil
+	}
+	log.Printf("Transferring leadership to %s (%s) with applied index %d and last contact %s", best.server.GetId(), best.server.GetAddress(), best.applied, best.lastContact)
+
+	started, err := leader.LeadershipTransferToServer(ctx, &pb.LeadershipTransferToServerRequest{
+		Id:      best.server.GetId(),
+		Address: best.server.GetAddress(),
+	})
+	if err != nil {
+		return err
+	}
+	f := started.Future()
+	resp, err := leader.Await(ctx, f)
+	if err != nil {
+		return err
+	}
+	if _, err := leader.Forget(ctx, f); err != nil {
+		return err
+	}
+	if resp.GetError() != "" {
+		return fmt.Errorf("leadership transfer failed: %s", resp.GetError())
+	}
+
+	// Wait until the new leader confirms it has taken over.
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	for {
+		if s, err := clients[best.server.GetAddress()].State(ctx, &pb.StateRequest{}); err == nil && s.GetState() == pb.StateResponse_LEADER {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s didn't become the leader: %v", best.server.GetId(), ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	log.Printf("%s (%s) is now the leader", best.server.GetId(), best.server.GetAddress())
+	return nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+// Exit codes of verify, so monitoring can tell the failure classes apart.
+const (
+	exitNoQuorum     = 2
+	exitDisagreement = 3
+	exitLagging      = 4
+)
+
+type verifySample struct {
+	endpoint string
+	stats    map[string]string
+	// configIndex is the index of the latest configuration, or 0 if the node doesn't know it. The "latest_configuration_index" in stats is always 0 with raft v1.5.
+	configIndex uint64
+	leader      string
+	err         error
+}
+
+// verify checks that a majority of voters respond, that they agree on the leader and term, and that nobody lags too far behind.
+func verify(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	maxLag := fs.Uint64("max-lag", 1000, "Maximum number of entries a node's applied index may be behind the leader's commit index")
+	timeout := fs.Duration("timeout", 5*time.Second, "How long to wait for each node to respond")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> verify [--max-lag=1000] [--timeout=5s]")
+	}
+	endpoints := splitTargets(target)
+	samples := make([]verifySample, len(endpoints))
+	// addresses are the raft addresses of the endpoints, as far as they know them.
+	addresses := make([]string, len(endpoints))
+	var configuration *pb.GetConfigurationResponse
+	for i, e := range endpoints {
+		ctx, cancel := context.WithTimeout(ctx, *timeout)
+		samples[i] = sampleForVerify(ctx, e)
+		if samples[i].err == nil {
+			var info *pb.ClusterInfoResponse
+			if info, samples[i].err = clusterInfoOf(ctx, e); samples[i].err == nil {
+				addresses[i] = info.GetAddress()
+				if configuration == nil {
+					configuration = info.GetConfiguration()
+				}
+			}
+		}
+		cancel()
+		if samples[i].err != nil {
+			log.Printf("%s: Error: %v", e, samples[i].err)
+		}
+	}
+
+	if configuration == nil {
+		return exitError{exitNoQuorum, fmt.Errorf("none of the %d endpoints responded", len(endpoints))}
+	}
+	voters := map[string]bool{}
+	for _, s := range configuration.GetServers() {
+		if s.GetSuffrage() == pb.GetConfigurationResponse_Server_VOTER {
+			voters[s.GetAddress()] = true
+		}
+	}
+	var responding []verifySample
+	respondingVoters := 0
+	for i, s := range samples {
+		if s.err != nil {
+			continue
+		}
+		responding = append(responding, s)
+		addr := addresses[i]
+		if addr == "" {
+			// The node doesn't know its own address. With raft-grpc-transport it's the endpoint.
+			addr = s.endpoint
+		}
+		if voters[addr] {
+			respondingVoters++
+		} else {
+			log.Printf("%s (%s) is not a voter; not counting it towards quorum", s.endpoint, addr)
+		}
+	}
+	quorum := len(voters)/2 + 1
+	if respondingVoters < quorum {
+		return exitError{exitNoQuorum, fmt.Errorf("only %d of the %d voters responded, but %d are needed for a quorum", respondingVoters, len(voters), quorum)}
+	}
+	log.Printf("%d voters responded; %d of the %d voters are needed for a quorum", respondingVoters, quorum, len(voters))
+
+	var leaderStats map[string]string
+	for _, s := range responding {
+		if s.leader == "" {
+			return exitError{exitDisagreement, fmt.Errorf("%s doesn't know of a leader", s.endpoint)}
+		}
+		if s.leader != responding[0].leader {
+			return exitError{exitDisagreement, fmt.Errorf("%s thinks %s is the leader, but %s thinks it's %s", s.endpoint, s.leader, responding[0].endpoint, responding[0].leader)}
+		}
+		if s.stats["term"] != responding[0].stats["term"] {
+			return exitError{exitDisagreement, fmt.Errorf("%s is in term %s, but %s is in term %s", s.endpoint, s.stats["term"], responding[0].endpoint, responding[0].stats["term"])}
+		}
+		if s.stats["state"] == "Leader" {
+			leaderStats = s.stats
+		}
+	}
+	log.Printf("All nodes agree that %s is the leader in term %s", responding[0].leader, responding[0].stats["term"])
+
+	if leaderStats == nil {
+		log.Printf("The leader is not in the target list; not checking lag")
+		return nil
+	}
+	commit, err := strconv.ParseUint(leaderStats["commit_index"], 10, 64)
+	if err != nil {
+		return err
+	}
+	for _, s := range responding {
+		applied, err := strconv.ParseUint(s.stats["applied_index"], 10, 64)
+		if err != nil {
+			return err
+		}
+		if commit > applied && commit-applied > *maxLag {
+			return exitError{exitLagging, fmt.Errorf("%s has applied index %d, which is %d behind the leader's commit index %d", s.endpoint, applied, commit-applied, commit)}
+		}
+	}
+	log.Printf("All nodes are within %d entries of the leader's commit index %d", *maxLag, commit)
+	return nil
+}
+
+func sampleForVerify(ctx context.Context, endpoint string) verifySample {
+	ret := verifySample{endpoint: endpoint}
+	conn, err := pool.get(endpoint)
+	if err != nil {
+		ret.err = err
+		return ret
+	}
+	c := pb.NewRaftAdminClient(conn)
+	stats, err := c.Stats(ctx, &pb.StatsRequest{})
+	if err != nil {
+		ret.err = err
+		return ret
+	}
+	ret.stats = stats.GetStats()
+	ret.configIndex = stats.GetLatestConfigurationIndex()
+	leader, err := c.Leader(ctx, &pb.LeaderRequest{})
+	if err != nil {
+		ret.err = err
+		return ret
+	}
+	ret.leader = leader.GetAddress()
+	return ret
+}
+
+func clusterInfoOf(ctx context.Context, endpoint string) (*pb.ClusterInfoResponse, error) {
+	conn, err := pool.get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewRaftAdminClient(conn).ClusterInfo(ctx, &pb.ClusterInfoRequest{})
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+func TestVerifyCountsOnlyVoters(t *testing.T) {
+	configuration := &pb.GetConfigurationResponse{}
+	for _, s := range []struct {
+		address  string
+		suffrage pb.GetConfigurationResponse_Server_Suffrage
+	}{
+		{"voter1:8300", pb.GetConfigurationResponse_Server_VOTER},
+		{"voter2:8300", pb.GetConfigurationResponse_Server_VOTER},
+		{"voter3:8300", pb.GetConfigurationResponse_Server_VOTER},
+		{"nonvoter1:8300", pb.GetConfigurationResponse_Server_NONVOTER},
+		{"nonvoter2:8300", pb.GetConfigurationResponse_Server_NONVOTER},
+	} {
+		configuration.Servers = append(configuration.Servers, &pb.GetConfigurationResponse_Server{Id: strings.TrimSuffix(s.address, ":8300"), Address: s.address, Suffrage: s.suffrage})
+	}
+	node := func(address, state string) *fakeNode {
+		return &fakeNode{
+			leader: "voter1:8300",
+			stats: &pb.StatsResponse{Stats: map[string]string{
+				"state":         state,
+				"term":          "2",
+				"commit_index":  "10",
+				"applied_index": "10",
+			}},
+			info: &pb.ClusterInfoResponse{Address: address, Configuration: configuration},
+		}
+	}
+	voter1 := serve(t, node("voter1:8300", "Leader"))
+	voter2 := serve(t, node("voter2:8300", "Follower"))
+	hanging := node("voter3:8300", "Follower")
+	hanging.hang = true
+	voter3 := serve(t, hanging)
+	nonvoter1 := serve(t, node("nonvoter1:8300", "Follower"))
+	nonvoter2 := serve(t, node("nonvoter2:8300", "Follower"))
+	ctx := context.Background()
+
+	if err := verify(ctx, strings.Join([]string{voter1, voter2, nonvoter1}, ","), nil); err != nil {
+		t.Errorf("verify with 2 of 3 voters failed: %v", err)
+	}
+
+	// Three nodes respond, but only one of them is a voter. The hanging voter must not block verify.
+	start := time.Now()
+	err := verify(ctx, strings.Join([]string{voter1, voter3, nonvoter1, nonvoter2, unreachable(t)}, ","), []string{"--timeout=200ms"})
+	var ee exitError
+	if !errors.As(err, &ee) || ee.code != exitNoQuorum {
+		t.Fatalf("verify with 1 of 3 voters returned %v, want exit code %d", err, exitNoQuorum)
+	}
+	if !strings.Contains(err.Error(), "only 1 of the 3 voters responded") {
+		t.Errorf("verify returned %q", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("verify took %s despite --timeout=200ms", d)
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+// verifyFSMAttempts is how often verify_fsm asks every node for its checksum before giving up on finding nodes at the same applied index.
+const verifyFSMAttempts = 3
+
+// verifyFSM compares the FSM checksums of the nodes in the target list. Only nodes at the same applied index can be compared, so if none are, it asks again after all nodes have applied the highest index it saw.
+func verifyFSM(ctx context.Context, target string, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> verify_fsm")
+	}
+	endpoints := splitTargets(target)
+	m := pb.File_raftadmin_proto.Services().ByName("RaftAdmin").Methods().ByName("VerifyFSM")
+	req := &pb.VerifyFSMRequest{TimeoutMs: 5000}
+	for attempt := 1; ; attempt++ {
+		byIndex := map[uint64]map[uint64][]string{}
+		var maxIndex uint64
+		failed := 0
+		for i, r := range fanoutInvoke(ctx, endpoints, m, req.ProtoReflect()) {
+			if r.err != nil {
+				failed++
+				log.Printf("%s: Error: %v", endpoints[i], r.err)
+				continue
+			}
+			resp := r.resp.Interface().(*pb.VerifyFSMResponse)
+			log.Printf("%s: checksum %016x at applied index %d", endpoints[i], resp.GetChecksum(), resp.GetAppliedIndex())
+			if byIndex[resp.GetAppliedIndex()] == nil {
+				byIndex[resp.GetAppliedIndex()] = map[uint64][]string{}
+			}
+			byIndex[resp.GetAppliedIndex()][resp.GetChecksum()] = append(byIndex[resp.GetAppliedIndex()][resp.GetChecksum()], endpoints[i])
+			if resp.GetAppliedIndex() > maxIndex {
+				maxIndex = resp.GetAppliedIndex()
+			}
+		}
+		compared := 0
+		for index, bySum := range byIndex {
+			if len(bySum) > 1 {
+				var groups []string
+				for sum, eps := range bySum {
+					groups = append(groups, fmt.Sprintf("%016x (%s)", sum, strings.Join(eps, ", ")))
+				}
+				sort.Strings(groups)
+				return exitError{exitDisagreement, fmt.Errorf("the FSMs diverged at applied index %d: %s", index, strings.Join(groups, ", "))}
+			}
+			for _, eps := range bySum {
+				if len(eps) > 1 {
+					compared += len(eps)
+				}
+			}
+		}
+		if compared > 0 {
+			log.Printf("%d nodes at the same applied index have the same checksum", compared)
+			if failed > 0 {
+				return fmt.Errorf("%d of %d endpoints failed", failed, len(endpoints))
+			}
+			return nil
+		}
+		if attempt == verifyFSMAttempts {
+			return fmt.Errorf("no two nodes were at the same applied index in %d attempts; try again when fewer entries are being applied", verifyFSMAttempts)
+		}
+		req.Index = maxIndex
+	}
+}
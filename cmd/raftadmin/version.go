@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// cliVersion returns the version of the raftadmin module this binary was built from.
+func cliVersion() string {
+	if bi, ok := debug.ReadBuildInfo(); ok && bi.Main.Version != "" {
+		return bi.Main.Version
+	}
+	return "(devel)"
+}
+
+// version prints the version of the CLI and the build info of every node in the target list, and checks whether the nodes can work together.
+func version(ctx context.Context, target string, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> version")
+	}
+	log.Printf("raftadmin CLI version %s", cliVersion())
+	endpoints := splitTargets(target)
+	m := pb.File_raftadmin_proto.Services().ByName("RaftAdmin").Methods().ByName("ServerInfo")
+	infos := map[string]*pb.ServerInfoResponse{}
+	failed := 0
+	for i, r := range fanoutInvoke(ctx, endpoints, m, (&pb.ServerInfoRequest{}).ProtoReflect()) {
+		if r.err != nil {
+			failed++
+			log.Printf("%s: Error: %v", endpoints[i], r.err)
+			continue
+		}
+		log.Printf("%s: Response: %s", endpoints[i], prototext.Format(r.resp.Interface()))
+		if si, ok := r.resp.Interface().(*pb.ServerInfoResponse); ok {
+			infos[endpoints[i]] = si
+		}
+	}
+	warnings, err := checkVersions(infos)
+	for _, w := range warnings {
+		log.Printf("Warning: %s", w)
+	}
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d endpoints failed", failed, len(endpoints))
+	}
+	return nil
+}
+
+// checkVersions compares the build info of the nodes. It returns warnings for differences that are expected during a rolling upgrade, and an error if there is no raft protocol or snapshot version that every node supports.
+func checkVersions(infos map[string]*pb.ServerInfoResponse) ([]string, error) {
+	if len(infos) < 2 {
+		return nil, nil
+	}
+	var warnings []string
+	for name, get := range map[string]func(si *pb.ServerInfoResponse) string{
+		"raftadmin":      (*pb.ServerInfoResponse).GetRaftadminVersion,
+		"hashicorp/raft": (*pb.ServerInfoResponse).GetRaftVersion,
+		"raft protocol":  func(si *pb.ServerInfoResponse) string { return fmt.Sprint(si.GetProtocolVersion()) },
+		"Go":             (*pb.ServerInfoResponse).GetGoVersion,
+	} {
+		byVersion := map[string][]string{}
+		for e, si := range infos {
+			byVersion[get(si)] = append(byVersion[get(si)], e)
+		}
+		if len(byVersion) > 1 {
+			warnings = append(warnings, fmt.Sprintf("nodes run different %s versions: %s", name, formatVersionGroups(byVersion)))
+		}
+	}
+	sort.Strings(warnings)
+
+	var incompatible []string
+	for name, get := range map[string]func(si *pb.ServerInfoResponse) (int64, int64){
+		"raft protocol": func(si *pb.ServerInfoResponse) (int64, int64) {
+			return si.GetProtocolVersionMin(), si.GetProtocolVersionMax()
+		},
+		"snapshot": func(si *pb.ServerInfoResponse) (int64, int64) {
+			return si.GetSnapshotVersionMin(), si.GetSnapshotVersionMax()
+		},
+	} {
+		first := true
+		var lo, hi int64
+		for _, si := range infos {
+			min, max := get(si)
+			if first || min > lo {
+				lo = min
+			}
+			if first || max < hi {
+				hi = max
+			}
+			first = false
+		}
+		if lo > hi {
+			incompatible = append(incompatible, name)
+		}
+	}
+	if len(incompatible) > 0 {
+		sort.Strings(incompatible)
+		return warnings, fmt.Errorf("there is no %s version that every node supports", strings.Join(incompatible, " or "))
+	}
+	return warnings, nil
+}
+
+// formatVersionGroups formats a map from version to endpoints as "v1 (a, b), v2 (c)".
+func formatVersionGroups(byVersion map[string][]string) string {
+	var groups []string
+	for v, endpoints := range byVersion {
+		sort.Strings(endpoints)
+		groups = append(groups, fmt.Sprintf("%s (%s)", v, strings.Join(endpoints, ", ")))
+	}
+	sort.Strings(groups)
+	return strings.Join(groups, ", ")
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+)
+
+// waitStable waits until all reachable nodes agree on the leader, term and configuration, and nobody is a candidate, for a number of consecutive checks.
+func waitStable(ctx context.Context, target string, args []string) error {
+	fs := flag.NewFlagSet("wait-stable", flag.ContinueOnError)
+	checks := fs.Int("checks", 3, "Number of consecutive checks the cluster needs to be stable for")
+	interval := fs.Duration("interval", time.Second, "Time between checks")
+	timeout := fs.Duration("timeout", 5*time.Minute, "How long to wait before giving up")
+	if err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 || *checks < 1 {
+		return fmt.Errorf("Usage: raftadmin <host:port,...> wait-stable [--checks=3] [--interval=1s] [--timeout=5m]")
+	}
+	endpoints := splitTargets(target)
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	stable := 0
+	var lastReason string
+	for {
+		reason := unstableReason(ctx, endpoints)
+		if reason == "" {
+			stable++
+			if stable >= *checks {
+				log.Printf("Cluster has been stable for %d consecutive checks", stable)
+				return nil
+			}
+		} else {
+			stable = 0
+			if reason != lastReason {
+				log.Printf("Not stable: %s", reason)
+			}
+		}
+		lastReason = reason
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("cluster didn't become stable within %s: %s", *timeout, lastReason)
+		case <-time.After(*interval):
+		}
+	}
+}
+
+// unstableReason returns why the cluster isn't stable right now, or "" if it is.
+// Unreachable nodes are ignored, but at least one node needs to respond.
+func unstableReason(ctx context.Context, endpoints []string) string {
+	// first is the first node that responded, and indexed the first that knows its configuration index. Nodes without raftadmin.WithLogStore don't.
+	var first, indexed *verifySample
+	for _, e := range endpoints {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		s := sampleForVerify(ctx, e)
+		cancel()
+		if s.err != nil {
+			continue
+		}
+		switch {
+		case s.stats["state"] == "Candidate":
+			return fmt.Sprintf("%s is a candidate", e)
+		case s.leader == "":
+			return fmt.Sprintf("%s doesn't know of a leader", e)
+		case first == nil:
+			first = &s
+		case s.leader != first.leader:
+			return fmt.Sprintf("%s thinks %s is the leader, but %s thinks it's %s", e, s.leader, first.endpoint, first.leader)
+		case s.stats["term"] != first.stats["term"]:
+			return fmt.Sprintf("%s is in term %s, but %s is in term %s", e, s.stats["term"], first.endpoint, first.stats["term"])
+		case s.configIndex != 0 && indexed != nil && s.configIndex != indexed.configIndex:
+			return fmt.Sprintf("%s has configuration index %d, but %s has %d", e, s.configIndex, indexed.endpoint, indexed.configIndex)
+		}
+		if s.configIndex != 0 && indexed == nil {
+			indexed = &s
+		}
+	}
+	if first == nil {
+		return "none of the nodes responded"
+	}
+	return ""
+}
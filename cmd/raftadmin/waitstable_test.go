@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+// stableNode returns a node that follows leader in term 2, with the given configuration index.
+func stableNode(configIndex uint64) *fakeNode {
+	return &fakeNode{
+		leader: "10.0.0.1:8300",
+		stats: &pb.StatsResponse{
+			Stats: map[string]string{
+				"state": "Follower",
+				"term":  "2",
+				// raft v1.5 always reports 0 here.
+				"latest_configuration_index": "0",
+			},
+			LatestConfigurationIndex: configIndex,
+		},
+	}
+}
+
+func TestUnstableReason(t *testing.T) {
+	ctx := context.Background()
+	a := serve(t, stableNode(5))
+	b := serve(t, stableNode(5))
+	behind := serve(t, stableNode(3))
+	unknown := serve(t, stableNode(0))
+
+	for _, tc := range []struct {
+		endpoints []string
+		want      string
+	}{
+		{[]string{a, b}, ""},
+		{[]string{a, behind}, behind + " has configuration index 3, but " + a + " has 5"},
+		// Nodes that don't know their configuration index are compared by the others.
+		{[]string{unknown, a, b}, ""},
+		{[]string{unknown, a, behind}, behind + " has configuration index 3, but " + a + " has 5"},
+		{[]string{unreachable(t), a}, ""},
+		{[]string{unreachable(t)}, "none of the nodes responded"},
+	} {
+		if got := unstableReason(ctx, tc.endpoints); got != tc.want {
+			t.Errorf("unstableReason(%s) = %q, want %q", strings.Join(tc.endpoints, ","), got, tc.want)
+		}
+	}
+
+	other := stableNode(5)
+	other.stats.Stats["term"] = "3"
+	if got := unstableReason(ctx, []string{a, serve(t, other)}); !strings.Contains(got, "in term 3") {
+		t.Errorf("unstableReason with different terms = %q", got)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// sampler runs a read-only command once and returns its formatted output.
+type sampler func(ctx context.Context) (string, error)
+
+var multilineText = prototext.MarshalOptions{Multiline: true}
+
+// connSampler returns a sampler that sends the request over an existing connection.
+func connSampler(conn *grpc.ClientConn, m protoreflect.MethodDescriptor, req protoreflect.Message) sampler {
+	return func(ctx context.Context) (string, error) {
+		resp, err := invoke(ctx, conn, m, req)
+		if err != nil {
+			return "", err
+		}
+		return multilineText.Format(resp.Interface()), nil
+	}
+}
+
+// fanoutSampler returns a sampler that sends the request to all endpoints and formats the responses per endpoint.
+func fanoutSampler(endpoints []string, m protoreflect.MethodDescriptor, req protoreflect.Message) sampler {
+	return func(ctx context.Context) (string, error) {
+		var sb strings.Builder
+		for i, r := range fanoutInvoke(ctx, endpoints, m, req) {
+			fmt.Fprintf(&sb, "%s:\n", endpoints[i])
+			if r.err != nil {
+				fmt.Fprintf(&sb, "  Error: %v\n", r.err)
+				continue
+			}
+			for _, l := range strings.Split(strings.TrimRight(multilineText.Format(r.resp.Interface()), "\n"), "\n") {
+				fmt.Fprintf(&sb, "  %s\n", l)
+			}
+		}
+		return sb.String(), nil
+	}
+}
+
+// watchLoop runs sample every interval, redraws the screen and highlights the parts of the output that changed since the previous sample.
+// It only returns if the context is cancelled.
+func watchLoop(ctx context.Context, interval time.Duration, title string, sample sampler) error {
+	var prev []string
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		out, err := sample(ctx)
+		if err != nil {
+			out = fmt.Sprintf("Error: %v", err)
+		}
+		lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+		// Move the cursor to the top left and clear the screen.
+		fmt.Print("\033[H\033[2J")
+		fmt.Printf("Every %s: %s\t%s\n\n", interval, title, time.Now().Format(time.RFC3339))
+		for i, l := range lines {
+			if prev == nil || (i < len(prev) && prev[i] == l) {
+				fmt.Println(l)
+				continue
+			}
+			var old string
+			if i < len(prev) {
+				old = prev[i]
+			}
+			fmt.Println(highlightChange(old, l))
+		}
+		prev = lines
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// highlightChange returns line with everything after the prefix it shares with old in reverse video.
+func highlightChange(old, line string) string {
+	// Don't start highlighting halfway a value; go back to the start of the key or value.
+	p := 0
+	for p < len(old) && p < len(line) && old[p] == line[p] {
+		p++
+	}
+	if i := strings.LastIndexAny(line[:p], " :"); i >= 0 {
+		p = i + 1
+	} else {
+		p = 0
+	}
+	return line[:p] + "\033[7m" + line[p:] + "\033[0m"
+}
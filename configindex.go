@@ -0,0 +1,71 @@
+package raftadmin
+
+import (
+	"sync"
+
+	"github.com/hashicorp/raft"
+)
+
+// configIndexCache remembers where configurationIndex last looked, so it only reads the entries appended since.
+type configIndexCache struct {
+	mtx sync.Mutex
+	// scanned is the last index that was read, and scannedTerm its term, to notice when raft replaced it.
+	scanned     uint64
+	scannedTerm uint64
+	// index is the last configuration entry up to scanned, or 0 if none was found.
+	index uint64
+}
+
+// configurationIndex returns the index of the log entry with the latest configuration, or 0 if it's unknown.
+// raft v1.5 doesn't report it (ConfigurationFuture.Index is always 0), so it's looked up in the LogStore given with WithLogStore. If the entry was compacted away, it's taken from the newest snapshot in the SnapshotStore given with WithSnapshotStore.
+func (a *admin) configurationIndex(reported uint64) uint64 {
+	if reported != 0 || a.logs == nil {
+		return reported
+	}
+	c := &a.configIndex
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	first, err := a.logs.FirstIndex()
+	if err != nil {
+		return 0
+	}
+	last, err := a.logs.LastIndex()
+	if err != nil {
+		return 0
+	}
+	if c.scanned != 0 {
+		var l raft.Log
+		if c.scanned < first || c.scanned > last || a.logs.GetLog(c.scanned, &l) != nil || l.Term != c.scannedTerm {
+			// Entries we didn't read were removed, or raft replaced the ones we did (like after a snapshot was installed). Start over.
+			c.scanned, c.scannedTerm, c.index = 0, 0, 0
+		}
+	}
+	from := first
+	if c.scanned != 0 {
+		from = c.scanned + 1
+	}
+	// Walk back from the end, as only the last configuration entry matters.
+	for i := last; i >= from && i > 0; i-- {
+		var l raft.Log
+		if err := a.logs.GetLog(i, &l); err != nil {
+			return 0
+		}
+		if i == last {
+			c.scannedTerm = l.Term
+		}
+		if l.Type == raft.LogConfiguration || l.Type == raft.LogAddPeerDeprecated || l.Type == raft.LogRemovePeerDeprecated {
+			c.index = i
+			break
+		}
+	}
+	if last >= from {
+		c.scanned = last
+	}
+	if c.index == 0 && a.snapshots != nil {
+		// The configuration is older than the log, so it's in the snapshot.
+		if snapshots, err := a.snapshots.List(); err == nil && len(snapshots) > 0 {
+			return snapshots[0].ConfigurationIndex
+		}
+	}
+	return c.index
+}
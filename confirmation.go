@@ -0,0 +1,129 @@
+package raftadmin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkConfirmation returns nil if token confirms method with the given effect. Without a token, it returns a FAILED_PRECONDITION error with a new token and the effect in an ErrorInfo detail, which clients can read with pb.ConfirmationFromError.
+func (a *admin) checkConfirmation(method, effect, token string) error {
+	if token != "" {
+		if a.validConfirmationToken(method, effect, token) {
+			return nil
+		}
+		return status.Errorf(codes.FailedPrecondition, "confirmation_token is invalid or expired, or the effect of %s changed; call it without a token to get a new one", method)
+	}
+	token = a.confirmationToken(method, effect, time.Now().Add(a.confirmationTTL).Unix())
+	msg := fmt.Sprintf("%s %s; send it again with confirmation_token %q within %s to confirm", method, effect, token, a.confirmationTTL)
+	st, err := status.New(codes.FailedPrecondition, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason: pb.ConfirmationRequiredReason,
+		Domain: pb.ErrorDomain,
+		Metadata: map[string]string{
+			"confirmation_token": token,
+			"effect":             effect,
+		},
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	return st.Err()
+}
+
+// confirmationToken returns the token confirming method with the given effect until expires. The effect includes the relevant parts of the configuration, so the token stops working if those change.
+func (a *admin) confirmationToken(method, effect string, expires int64) string {
+	h := hmac.New(sha256.New, a.confirmationSecret)
+	fmt.Fprintf(h, "%s\x00%s\x00%d", method, effect, expires)
+	return fmt.Sprintf("%d.%x", expires, h.Sum(nil)[:8])
+}
+
+func (a *admin) validConfirmationToken(method, effect, token string) bool {
+	i := strings.IndexByte(token, '.')
+	if i < 0 {
+		return false
+	}
+	expires, err := strconv.ParseInt(token[:i], 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return false
+	}
+	return hmac.Equal([]byte(token), []byte(a.confirmationToken(method, effect, expires)))
+}
+
+// removeServerEffect describes what removing the server with the given ID does to the cluster.
+func (a *admin) removeServerEffect(id raft.ServerID) (string, error) {
+	f := a.r.GetConfiguration()
+	if err := f.Error(); err != nil {
+		return "", err
+	}
+	voters := countVoters(f.Configuration())
+	for _, s := range f.Configuration().Servers {
+		if s.ID != id {
+			continue
+		}
+		if s.Suffrage != raft.Voter {
+			return fmt.Sprintf("will remove %s %s (%s), which doesn't change the quorum of %d/%d voters", strings.ToLower(s.Suffrage.String()), s.ID, s.Address, quorumSize(voters), voters), nil
+		}
+		effect := fmt.Sprintf("will remove voter %s (%s), so quorum goes from %d/%d to %d/%d voters", s.ID, s.Address, quorumSize(voters), voters, quorumSize(voters-1), voters-1)
+		if _, leader := a.r.LeaderWithID(); leader == id {
+			effect += ", and it is the leader"
+		}
+		return effect, nil
+	}
+	return fmt.Sprintf("will remove %s, which isn't in the configuration", id), nil
+}
+
+// shutdownEffect describes what shutting down this node does to the cluster.
+func (a *admin) shutdownEffect() (string, error) {
+	f := a.r.GetConfiguration()
+	if err := f.Error(); err != nil {
+		return "", err
+	}
+	addr := raft.ServerAddress(a.localAddress())
+	voters := countVoters(f.Configuration())
+	for _, s := range f.Configuration().Servers {
+		if s.Address != addr {
+			continue
+		}
+		if s.Suffrage != raft.Voter {
+			return fmt.Sprintf("will shut down %s %s (%s), which doesn't affect the quorum", strings.ToLower(s.Suffrage.String()), s.ID, s.Address), nil
+		}
+		effect := fmt.Sprintf("will shut down voter %s (%s), leaving %d other voters of which %d are needed for quorum", s.ID, s.Address, voters-1, quorumSize(voters))
+		if quorumSize(voters) > voters-1 {
+			effect = fmt.Sprintf("will shut down voter %s (%s), leaving %d other voters while %d are needed for quorum, so the cluster loses quorum", s.ID, s.Address, voters-1, quorumSize(voters))
+		}
+		if a.r.State() == raft.Leader {
+			effect += ", and it is the leader"
+		}
+		return effect, nil
+	}
+	return fmt.Sprintf("will shut down %s, which isn't in the configuration", addr), nil
+}
+
+// restoreSnapshotEffect describes what restoring the snapshot with the given metadata does to the cluster.
+func restoreSnapshotEffect(meta *pb.SnapshotMeta) string {
+	return fmt.Sprintf("will replace the state of the entire cluster with snapshot %s (index %d, term %d, %d bytes)", meta.GetId(), meta.GetIndex(), meta.GetTerm(), meta.GetSize())
+}
+
+func countVoters(c raft.Configuration) int {
+	n := 0
+	for _, s := range c.Servers {
+		if s.Suffrage == raft.Voter {
+			n++
+		}
+	}
+	return n
+}
+
+// quorumSize returns how many of n voters are needed for quorum.
+func quorumSize(n int) int {
+	return n/2 + 1
+}
@@ -0,0 +1,63 @@
+package raftadmin
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc/codes"
+)
+
+func TestConfirmation(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	leader := bootstrap(t, nodes[0])
+	s := newTestServer(t, leader.r, WithConfirmation(time.Minute))
+	ctx := context.Background()
+	f, err := s.a.AddNonvoter(ctx, &pb.AddNonvoterRequest{Id: string(nodes[1].id), Address: string(nodes[1].addr)})
+	awaitOK(t, s.a, f, err)
+
+	req := &pb.RemoveServerRequest{Id: string(nodes[1].id)}
+	_, err = s.a.RemoveServer(ctx, req)
+	wantCode(t, err, codes.FailedPrecondition, "confirmation_token")
+	token, effect, ok := pb.ConfirmationFromError(err)
+	if !ok || token == "" {
+		t.Fatalf("RemoveServer without a token returned %v, which has no confirmation token", err)
+	}
+	if want := "will remove nonvoter " + string(nodes[1].id); !strings.Contains(effect, want) {
+		t.Errorf("effect is %q, want it to contain %q", effect, want)
+	}
+
+	req.ConfirmationToken = "1." + token
+	_, err = s.a.RemoveServer(ctx, req)
+	wantCode(t, err, codes.FailedPrecondition, "invalid or expired")
+	if !hasServer(t, leader.r, nodes[1].id) {
+		t.Fatalf("%s was removed with an invalid token", nodes[1].id)
+	}
+	// A token for one method doesn't confirm another.
+	_, err = s.a.Shutdown(ctx, &pb.ShutdownRequest{ConfirmationToken: token})
+	wantCode(t, err, codes.FailedPrecondition, "invalid or expired")
+
+	req.ConfirmationToken = token
+	f, err = s.a.RemoveServer(ctx, req)
+	awaitOK(t, s.a, f, err)
+	if hasServer(t, leader.r, nodes[1].id) {
+		t.Fatalf("%s wasn't removed with a valid token", nodes[1].id)
+	}
+
+	_, err = s.a.Shutdown(ctx, &pb.ShutdownRequest{})
+	token, effect, ok = pb.ConfirmationFromError(err)
+	if !ok {
+		t.Fatalf("Shutdown without a token returned %v, which has no confirmation token", err)
+	}
+	if !strings.Contains(effect, "loses quorum") || !strings.Contains(effect, "it is the leader") {
+		t.Errorf("effect of shutting down the only voter is %q", effect)
+	}
+	f, err = s.a.Shutdown(ctx, &pb.ShutdownRequest{ConfirmationToken: token})
+	awaitOK(t, s.a, f, err)
+	if st := leader.r.State(); st != raft.Shutdown {
+		t.Errorf("raft is %s after a confirmed Shutdown", st)
+	}
+}
@@ -0,0 +1,75 @@
+package raftadmin
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Drain stops the server from starting new operations, and waits until nobody is awaiting the operations it started, or until ctx expires. Then it closes the server like Close.
+// Call it during shutdown, before stopping the grpc.Server, so clients still get the results of the operations they're waiting for. It returns ctx.Err() if it stopped waiting early.
+func (s *Server) Drain(ctx context.Context) error {
+	a := s.a
+	atomic.StoreInt32(&a.draining, 1)
+	t := time.NewTicker(10 * time.Millisecond)
+	defer t.Stop()
+	var err error
+	for err == nil && a.numAwaiting() > 0 {
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			err = ctx.Err()
+			if a.logger != nil {
+				a.logger.Warn("raftadmin stopped waiting for Awaits before closing", "awaiting", a.numAwaiting(), "error", err)
+			}
+		}
+	}
+	s.Close()
+	return err
+}
+
+// Close stops the server from starting new operations and forgets the ones it started. Calls that are awaiting them still get their result.
+// It also stops watching raft and closes the connections to other nodes. Calls that need those fail or return stale data afterwards, so stop serving the RaftAdmin service first.
+func (s *Server) Close() {
+	a := s.a
+	a.closeOnce.Do(func() {
+		atomic.StoreInt32(&a.draining, 1)
+		close(a.closed)
+		a.heartbeats.stop(a.r)
+		a.metrics.stop(a.r)
+
+		mtx.Lock()
+		forgotten := 0
+		for token, f := range operations {
+			if f.owner == a {
+				forgetLocked(token, f)
+				forgotten++
+			}
+		}
+		mtx.Unlock()
+
+		a.peerMtx.Lock()
+		for addr, c := range a.peerConns {
+			c.Close()
+			delete(a.peerConns, addr)
+		}
+		a.peerMtx.Unlock()
+
+		if a.logger != nil {
+			a.logger.Info("raftadmin server closed", "forgotten", forgotten)
+		}
+	})
+}
+
+// numAwaiting returns the number of operations started by this server that someone is awaiting.
+func (a *admin) numAwaiting() int {
+	mtx.Lock()
+	defer mtx.Unlock()
+	n := 0
+	for _, f := range operations {
+		if f.owner == a && atomic.LoadInt32(&f.awaiting) > 0 {
+			n++
+		}
+	}
+	return n
+}
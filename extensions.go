@@ -0,0 +1,172 @@
+package raftadmin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Extension is an application-specific method, served by WithExtension next to the RaftAdmin service.
+type Extension struct {
+	// Name of the method, in CamelCase like "CompactCache". raftadmin calls it as compact_cache.
+	Name string
+	// Request and Response are instances of the request and response messages. They must be generated messages, so their descriptors are known to gRPC reflection.
+	Request  proto.Message
+	Response proto.Message
+	// Handler handles a call. req is of the same type as Request, and the result must be of the same type as Response.
+	Handler func(ctx context.Context, req proto.Message) (proto.Message, error)
+	// ReadOnly means the method doesn't change any state, so WithReadOnly and the "read-only" group of policies allow it.
+	ReadOnly bool
+}
+
+// registeredExtensions are the extensions passed to WithExtension anywhere in this process, by name. All servers share the RaftAdminExtensions service descriptor, so an extension must be the same for all of them, except for its Handler.
+var (
+	extensionsMtx        sync.Mutex
+	registeredExtensions = map[string]Extension{}
+	// extensionsFile is the descriptor of the RaftAdminExtensions service, once it has been registered with protoregistry.GlobalFiles.
+	extensionsFile protoreflect.FileDescriptor
+)
+
+// registerExtension records the name and types of e, so options that take method names accept it.
+func registerExtension(e Extension) {
+	if !protoreflect.Name(e.Name).IsValid() || isRaftAdminMethod(e.Name) {
+		panic(fmt.Errorf("WithExtension: invalid or reserved method name %q", e.Name))
+	}
+	if e.Request == nil || e.Response == nil || e.Handler == nil {
+		panic(fmt.Errorf("WithExtension: %s needs a Request, Response and Handler", e.Name))
+	}
+	extensionsMtx.Lock()
+	defer extensionsMtx.Unlock()
+	if o, ok := registeredExtensions[e.Name]; ok {
+		if o.Request.ProtoReflect().Descriptor() != e.Request.ProtoReflect().Descriptor() || o.Response.ProtoReflect().Descriptor() != e.Response.ProtoReflect().Descriptor() || o.ReadOnly != e.ReadOnly {
+			panic(fmt.Errorf("WithExtension: %s was already registered with different types", e.Name))
+		}
+		return
+	}
+	if extensionsFile != nil {
+		panic(fmt.Errorf("WithExtension: %s is new, but servers with extensions were already registered; create all extensions first", e.Name))
+	}
+	registeredExtensions[e.Name] = e
+}
+
+// isExtension returns whether method was registered with WithExtension.
+func isExtension(method string) bool {
+	extensionsMtx.Lock()
+	defer extensionsMtx.Unlock()
+	_, ok := registeredExtensions[method]
+	return ok
+}
+
+// isReadOnlyMethod returns whether method is one of the readOnlyMethods or a read-only extension.
+func isReadOnlyMethod(method string) bool {
+	if readOnlyMethods[method] {
+		return true
+	}
+	extensionsMtx.Lock()
+	defer extensionsMtx.Unlock()
+	return registeredExtensions[method].ReadOnly
+}
+
+// extensionsDescriptor returns the descriptor of the RaftAdminExtensions service, registering it with protoregistry.GlobalFiles the first time, so gRPC reflection serves it.
+func extensionsDescriptor() protoreflect.ServiceDescriptor {
+	extensionsMtx.Lock()
+	defer extensionsMtx.Unlock()
+	if extensionsFile == nil {
+		names := make([]string, 0, len(registeredExtensions))
+		for n := range registeredExtensions {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		fdp := &descriptorpb.FileDescriptorProto{
+			Name:    proto.String("raftadmin_extensions.proto"),
+			Syntax:  proto.String("proto3"),
+			Options: &descriptorpb.FileOptions{GoPackage: proto.String("github.com/Jille/raftadmin/proto")},
+		}
+		svc := &descriptorpb.ServiceDescriptorProto{Name: proto.String(pb.ExtensionServiceName)}
+		deps := map[string]bool{}
+		for _, n := range names {
+			e := registeredExtensions[n]
+			in := e.Request.ProtoReflect().Descriptor()
+			out := e.Response.ProtoReflect().Descriptor()
+			for _, d := range []protoreflect.MessageDescriptor{in, out} {
+				if p := d.ParentFile().Path(); !deps[p] {
+					deps[p] = true
+					fdp.Dependency = append(fdp.Dependency, p)
+				}
+			}
+			m := &descriptorpb.MethodDescriptorProto{
+				Name:       proto.String(n),
+				InputType:  proto.String("." + string(in.FullName())),
+				OutputType: proto.String("." + string(out.FullName())),
+			}
+			if e.ReadOnly {
+				m.Options = &descriptorpb.MethodOptions{IdempotencyLevel: descriptorpb.MethodOptions_NO_SIDE_EFFECTS.Enum()}
+			}
+			svc.Method = append(svc.Method, m)
+		}
+		fdp.Service = []*descriptorpb.ServiceDescriptorProto{svc}
+		fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+		if err != nil {
+			panic(fmt.Errorf("raftadmin: failed to build the descriptor of the extensions: %v", err))
+		}
+		if err := protoregistry.GlobalFiles.RegisterFile(fd); err != nil {
+			panic(fmt.Errorf("raftadmin: failed to register the descriptor of the extensions: %v", err))
+		}
+		extensionsFile = fd
+	}
+	return extensionsFile.Services().Get(0)
+}
+
+// extensionServiceDesc returns the description of the RaftAdminExtensions service with the extensions of this server, or nil if it has none.
+// Like serviceDesc, every call passes through interceptUnary. Methods registered by other servers in this process return UNIMPLEMENTED.
+func (a *admin) extensionServiceDesc() *grpc.ServiceDesc {
+	if len(a.extensions) == 0 {
+		return nil
+	}
+	sd := extensionsDescriptor()
+	d := &grpc.ServiceDesc{
+		ServiceName: pb.ExtensionServiceName,
+		HandlerType: (*interface{})(nil),
+		Metadata:    sd.ParentFile().Path(),
+	}
+	for i := 0; sd.Methods().Len() > i; i++ {
+		name := string(sd.Methods().Get(i).Name())
+		e, ok := a.extensions[name]
+		if !ok {
+			continue
+		}
+		info := &grpc.UnaryServerInfo{
+			Server:     a,
+			FullMethod: "/" + pb.ExtensionServiceName + "/" + name,
+		}
+		d.Methods = append(d.Methods, grpc.MethodDesc{
+			MethodName: name,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := e.Request.ProtoReflect().New().Interface()
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return e.Handler(ctx, req.(proto.Message))
+				}
+				inner := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return a.interceptUnary(ctx, req, info, handler)
+				}
+				if interceptor == nil {
+					return inner(ctx, req)
+				}
+				return interceptor(ctx, req, info, inner)
+			},
+		})
+	}
+	return d
+}
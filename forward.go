@@ -0,0 +1,134 @@
+package raftadmin
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// forwardedMetadataKey marks calls forwarded by another node, so they aren't forwarded again. Its value is the address of the node that forwarded it.
+const forwardedMetadataKey = "raftadmin-forwarded-by"
+
+// leaderMethods are the RPCs that only work on the leader, and that WithLeaderForwarding forwards to it.
+var leaderMethods = map[string]bool{
+	"AddNonvoter":                true,
+	"AddVoter":                   true,
+	"ApplyBatch":                 true,
+	"ApplyLog":                   true,
+	"Barrier":                    true,
+	"DemoteVoter":                true,
+	"LeadershipTransfer":         true,
+	"LeadershipTransferToServer": true,
+	"RemoveServer":               true,
+	"VerifyLeader":               true,
+}
+
+// forwardedFutures remembers on which node the operations forwarded by WithLeaderForwarding live, so Await and Forget can follow them.
+type forwardedFutures struct {
+	mtx    sync.Mutex
+	tokens map[string]*forwardedFuture
+}
+
+type forwardedFuture struct {
+	addr raft.ServerAddress
+	// expires is when reapFutures forgets the token, with WithFutureTTL. Every Await or Forget pushes it back, like an await of a local operation keeps it alive.
+	expires time.Time
+}
+
+// reap forgets the tokens that expired before now.
+func (f *forwardedFutures) reap(now time.Time) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	for token, ff := range f.tokens {
+		if !ff.expires.IsZero() && now.After(ff.expires) {
+			delete(f.tokens, token)
+		}
+	}
+}
+
+// forwardTarget returns the address of the node a call should be forwarded to, if any.
+func (a *admin) forwardTarget(ctx context.Context, method string, req interface{}) (raft.ServerAddress, bool) {
+	if a.forwarded == nil {
+		return "", false
+	}
+	switch method {
+	case "Await", "Forget", "AwaitAndForget":
+		a.forwarded.mtx.Lock()
+		defer a.forwarded.mtx.Unlock()
+		ff, ok := a.forwarded.tokens[req.(*pb.Future).GetOperationToken()]
+		if !ok {
+			return "", false
+		}
+		if a.futureTTL > 0 {
+			ff.expires = time.Now().Add(a.futureTTL)
+		}
+		return ff.addr, true
+	}
+	if !leaderMethods[method] || a.r.State() == raft.Leader {
+		return "", false
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(forwardedMetadataKey)) > 0 {
+		// Whoever forwarded this thought we were the leader. Don't bounce it around; let it fail here.
+		return "", false
+	}
+	addr, _ := a.r.LeaderWithID()
+	return addr, addr != ""
+}
+
+// forward sends a unary call to the node at addr, with the metadata of the incoming call and its deadline.
+func (a *admin) forward(ctx context.Context, addr raft.ServerAddress, fullMethod, method string, req interface{}) (interface{}, error) {
+	if a.peerDialOptions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "raftadmin.WithLeaderForwarding requires raftadmin.WithPeerDialOptions")
+	}
+	if a.logger != nil {
+		a.logger.Debug("raftadmin forwarding call", "method", method, "to", addr)
+	}
+	conn, err := a.peerConn(addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to connect to %s to forward %s: %v", addr, method, err)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	out := metadata.MD{}
+	for k, v := range md {
+		// Leave out the headers that describe the incoming connection, which gRPC sets itself.
+		// The cluster is added by the connection from peerConn.
+		if strings.HasPrefix(k, ":") || strings.HasPrefix(k, "grpc-") || k == "content-type" || k == "user-agent" || k == pb.ClusterMetadataKey {
+			continue
+		}
+		out[k] = v
+	}
+	out.Set(forwardedMetadataKey, a.localAddress())
+	m := pb.File_raftadmin_proto.Services().ByName("RaftAdmin").Methods().ByName(protoreflect.Name(method))
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(m.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	resp := mt.New().Interface()
+	if err := conn.Invoke(metadata.NewOutgoingContext(ctx, out), fullMethod, req, resp); err != nil {
+		return nil, err
+	}
+
+	a.forwarded.mtx.Lock()
+	defer a.forwarded.mtx.Unlock()
+	if f, ok := resp.(*pb.FutureResponse); ok && f.GetResult() == nil {
+		ff := &forwardedFuture{addr: addr}
+		if a.futureTTL > 0 {
+			ff.expires = time.Now().Add(a.futureTTL)
+		}
+		a.forwarded.tokens[f.GetOperationToken()] = ff
+	}
+	if method == "Forget" || method == "AwaitAndForget" {
+		delete(a.forwarded.tokens, req.(*pb.Future).GetOperationToken())
+	}
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package raftadmin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+)
+
+func TestForwardedFuturesReap(t *testing.T) {
+	a := &admin{
+		futureTTL: time.Minute,
+		forwarded: &forwardedFutures{tokens: map[string]*forwardedFuture{
+			"old":     {addr: "leader:8300", expires: time.Now().Add(-time.Second)},
+			"awaited": {addr: "leader:8300", expires: time.Now().Add(-time.Second)},
+			"new":     {addr: "leader:8300", expires: time.Now().Add(time.Minute)},
+			"no-ttl":  {addr: "leader:8300"},
+		}},
+	}
+	// Awaiting through this node keeps the token around for another TTL.
+	if addr, ok := a.forwardTarget(context.Background(), "Await", &pb.Future{OperationToken: "awaited"}); !ok || addr != "leader:8300" {
+		t.Fatalf("forwardTarget(Await) = %q, %v; want the leader", addr, ok)
+	}
+	a.forwarded.reap(time.Now())
+	for token, want := range map[string]bool{"old": false, "awaited": true, "new": true, "no-ttl": true} {
+		if _, ok := a.forwarded.tokens[token]; ok != want {
+			t.Errorf("after reaping, token %q is known: %v, want %v", token, ok, want)
+		}
+	}
+	if _, ok := a.forwardTarget(context.Background(), "Forget", &pb.Future{OperationToken: "old"}); ok {
+		t.Errorf("Forget of a reaped token is still forwarded")
+	}
+}
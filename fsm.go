@@ -0,0 +1,41 @@
+package raftadmin
+
+import (
+	"context"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fsmChecksumAttempts is how often VerifyFSM computes the checksum before giving up because entries keep being applied.
+const fsmChecksumAttempts = 5
+
+func (a *admin) VerifyFSM(ctx context.Context, req *pb.VerifyFSMRequest) (*pb.VerifyFSMResponse, error) {
+	if a.fsmChecksum == nil {
+		return nil, status.Error(codes.FailedPrecondition, "VerifyFSM requires raftadmin.WithFSMChecksum")
+	}
+	if req.GetIndex() > 0 {
+		if _, err := a.WaitForIndex(ctx, &pb.WaitForIndexRequest{Index: req.GetIndex(), TimeoutMs: req.GetTimeoutMs()}); err != nil {
+			return nil, err
+		}
+	}
+	// The checksum only belongs to an applied index if no entries were applied while it was computed.
+	for i := 0; i < fsmChecksumAttempts; i++ {
+		before := a.r.AppliedIndex()
+		sum, err := a.fsmChecksum()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "FSM checksum failed: %v", err)
+		}
+		if a.r.AppliedIndex() == before {
+			return &pb.VerifyFSMResponse{
+				Checksum:     sum,
+				AppliedIndex: before,
+			}, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+	}
+	return nil, status.Errorf(codes.Aborted, "entries were applied while computing the checksum in all %d attempts", fsmChecksumAttempts)
+}
@@ -0,0 +1,107 @@
+package raftadmin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc/metadata"
+)
+
+// wait waits for the raft operation to finish and records its outcome. With WithMethodTimeout, it gives up after the timeout of the method.
+func (f *future) wait(token string) {
+	if f.timeout > 0 {
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- f.f.Error()
+		}()
+		t := time.NewTimer(f.timeout)
+		select {
+		case f.err = <-errCh:
+		case <-t.C:
+			// raft can't cancel operations, so it might still happen.
+			f.err = fmt.Errorf("%s didn't finish within %s; it may still happen", f.operation, f.timeout)
+		}
+		t.Stop()
+	} else {
+		f.err = f.f.Error()
+	}
+	if ifx, ok := f.f.(raft.IndexFuture); ok && f.err == nil {
+		f.index = ifx.Index()
+	}
+	close(f.done)
+	if l := f.owner.logger; l != nil {
+		if f.err != nil {
+			l.Warn("raftadmin operation failed", "operation", f.operation, "token", token, "duration", time.Since(f.created), "error", f.err)
+		} else {
+			l.Info("raftadmin operation completed", "operation", f.operation, "token", token, "duration", time.Since(f.created))
+		}
+	}
+}
+
+// reapFutures periodically forgets the futures created with WithFutureTTL that expired, finished and that nobody is awaiting, and the tokens of expired forwarded futures. It returns when the server is closed.
+func (a *admin) reapFutures() {
+	interval := a.futureTTL / 4
+	if interval < time.Second {
+		interval = time.Second
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		var now time.Time
+		select {
+		case now = <-t.C:
+		case <-a.closed:
+			return
+		}
+		mtx.Lock()
+		for token, f := range operations {
+			if f.owner != a || f.expires.IsZero() || now.Before(f.expires) || atomic.LoadInt32(&f.awaiting) > 0 {
+				continue
+			}
+			select {
+			case <-f.done:
+			default:
+				continue
+			}
+			forgetLocked(token, f)
+			a.metrics.reapedFutures.Inc()
+			if a.logger != nil {
+				a.logger.Warn("raftadmin operation reaped because nobody awaited or forgot it", "operation", f.operation, "token", token, "age", time.Since(f.created))
+			}
+		}
+		mtx.Unlock()
+		if a.forwarded != nil {
+			a.forwarded.reap(now)
+		}
+	}
+}
+
+// forgetLocked removes a future from operations. The caller must hold mtx.
+func forgetLocked(token string, f *future) {
+	delete(operations, token)
+	atomic.AddInt32(&f.owner.numFutures, -1)
+}
+
+// futureScope returns who may Await and Forget the operations the caller starts: the holder of the same bearer token if it sent one, or else of a TLS client certificate with the same subject.
+// It returns "" for anonymous callers, whose operations anyone who knows the token can use.
+func futureScope(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vals := md.Get("authorization"); len(vals) > 0 {
+		h := sha256.Sum256([]byte(vals[0]))
+		return "token:" + hex.EncodeToString(h[:])
+	}
+	if cert, ok := ClientCertificate(ctx); ok {
+		return "certificate:" + cert.Subject.String()
+	}
+	return ""
+}
+
+// visibleTo returns whether the caller may Await and Forget f.
+func (f *future) visibleTo(ctx context.Context) bool {
+	return f.scope == "" || f.scope == futureScope(ctx)
+}
@@ -0,0 +1,123 @@
+package raftadmin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultMaxLag is the default for ServerHealthRequest.max_lag, the same as Consul's autopilot uses.
+	defaultMaxLag = 250
+	// defaultMinStable is the default for ServerHealthRequest.min_stable_ms, the same as Consul's autopilot uses.
+	defaultMinStable = 10 * time.Second
+)
+
+func (a *admin) ServerHealth(ctx context.Context, req *pb.ServerHealthRequest) (*pb.ServerHealthResponse, error) {
+	if a.r.State() != raft.Leader {
+		return nil, a.notLeaderError("ServerHealth")
+	}
+	maxLag := req.GetMaxLag()
+	if maxLag == 0 {
+		maxLag = defaultMaxLag
+	}
+	minStable := time.Duration(req.GetMinStableMs()) * time.Millisecond
+	if minStable == 0 {
+		minStable = defaultMinStable
+	}
+	cf := a.r.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, err
+	}
+	lastIndex, err := strconv.ParseUint(a.r.Stats()["last_log_index"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse last_log_index: %v", err)
+	}
+
+	_, self := a.r.LeaderWithID()
+	now := time.Now()
+	ret := &pb.ServerHealthResponse{}
+	var wg sync.WaitGroup
+	for _, s := range cf.Configuration().Servers {
+		if req.GetId() != "" && string(s.ID) != req.GetId() {
+			continue
+		}
+		h := &pb.ServerHealthResponse_Server{
+			Id:      string(s.ID),
+			Address: string(s.Address),
+			Leader:  s.ID == self,
+		}
+		if h.Suffrage, err = toSuffrage(s); err != nil {
+			return nil, err
+		}
+		since, alive := a.heartbeats.stableSince(s.ID)
+		h.Alive = alive
+		if alive && !since.IsZero() {
+			h.StableSinceUnixNano = since.UnixNano()
+		}
+		ret.Servers = append(ret.Servers, h)
+		wg.Add(1)
+		go func(s raft.Server, h *pb.ServerHealthResponse_Server) {
+			defer wg.Done()
+			switch {
+			case !h.GetAlive():
+				h.Reason = "heartbeats are failing"
+			case now.Sub(time.Unix(0, h.GetStableSinceUnixNano())) < minStable:
+				h.Reason = fmt.Sprintf("not stable for %s yet", minStable)
+			case h.GetLeader() || a.peerDialOptions == nil:
+				// The leader doesn't lag behind itself, and without peer access we can't ask followers.
+				h.Healthy = true
+			default:
+				f := &pb.ReplicationStatusResponse_Follower{}
+				if err := a.fillFollower(ctx, s, f, lastIndex); err != nil {
+					h.Reason = fmt.Sprintf("failed to get lag: %v", err)
+					return
+				}
+				h.Lag = f.GetLag()
+				if h.Lag > maxLag {
+					h.Reason = fmt.Sprintf("lagging %d entries behind", h.Lag)
+					return
+				}
+				h.Healthy = true
+			}
+		}(s, h)
+	}
+	wg.Wait()
+	if req.GetId() != "" && len(ret.Servers) == 0 {
+		return nil, status.Errorf(codes.NotFound, "server %q is not in the configuration", req.GetId())
+	}
+	return ret, nil
+}
+
+func (a *admin) QuorumStatus(ctx context.Context, req *pb.QuorumStatusRequest) (*pb.QuorumStatusResponse, error) {
+	if a.r.State() != raft.Leader {
+		return nil, a.notLeaderError("QuorumStatus")
+	}
+	cf := a.r.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, err
+	}
+	_, self := a.r.LeaderWithID()
+	ret := &pb.QuorumStatusResponse{}
+	for _, s := range cf.Configuration().Servers {
+		if s.Suffrage != raft.Voter {
+			continue
+		}
+		ret.Voters++
+		if _, alive := a.heartbeats.stableSince(s.ID); alive || s.ID == self {
+			ret.ReachableVoters++
+		} else {
+			ret.UnreachableVoters = append(ret.UnreachableVoters, string(s.ID))
+		}
+	}
+	ret.QuorumSize = ret.Voters/2 + 1
+	ret.FailureTolerance = int64(ret.ReachableVoters) - int64(ret.QuorumSize)
+	return ret, nil
+}
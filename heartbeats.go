@@ -0,0 +1,161 @@
+package raftadmin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+)
+
+// peerLastContactTimeout bounds how long PeerLastContact waits for followers to say when they last heard from the leader.
+const peerLastContactTimeout = time.Second
+
+// heartbeatTracker keeps track of which peers the leader fails to heartbeat, and when it last heard from them.
+type heartbeatTracker struct {
+	mtx     sync.Mutex
+	failing map[raft.ServerID]time.Time
+	// resumed is when heartbeats to a peer started succeeding again after failures.
+	resumed map[raft.ServerID]time.Time
+	// leaderSince is when this node last became leader.
+	leaderSince time.Time
+
+	observer *raft.Observer
+	ch       chan raft.Observation
+}
+
+func newHeartbeatTracker(r Raft) *heartbeatTracker {
+	t := &heartbeatTracker{
+		failing: map[raft.ServerID]time.Time{},
+		resumed: map[raft.ServerID]time.Time{},
+	}
+	t.ch = make(chan raft.Observation, 16)
+	t.observer = raft.NewObserver(t.ch, false, func(o *raft.Observation) bool {
+		switch o.Data.(type) {
+		case raft.FailedHeartbeatObservation, raft.ResumedHeartbeatObservation, raft.RaftState:
+			return true
+		default:
+			return false
+		}
+	})
+	r.RegisterObserver(t.observer)
+	go func() {
+		for o := range t.ch {
+			t.mtx.Lock()
+			switch d := o.Data.(type) {
+			case raft.FailedHeartbeatObservation:
+				t.failing[d.PeerID] = d.LastContact
+				delete(t.resumed, d.PeerID)
+			case raft.ResumedHeartbeatObservation:
+				delete(t.failing, d.PeerID)
+				t.resumed[d.PeerID] = time.Now()
+			case raft.RaftState:
+				// Heartbeats are only sent by the leader, and start afresh with every term.
+				t.failing = map[raft.ServerID]time.Time{}
+				t.resumed = map[raft.ServerID]time.Time{}
+				if d == raft.Leader {
+					t.leaderSince = time.Now()
+				}
+			}
+			t.mtx.Unlock()
+		}
+	}()
+	return t
+}
+
+// stop stops tracking heartbeats.
+func (t *heartbeatTracker) stop(r Raft) {
+	r.DeregisterObserver(t.observer)
+	// Raft no longer sends on ch once the observer is deregistered.
+	close(t.ch)
+}
+
+// stableSince returns since when heartbeats to the peer have been succeeding without interruption, as far as this leader knows, or false if they're currently failing.
+// Servers from Get don't track heartbeats, and consider them all succeeding since an unknown time.
+func (t *heartbeatTracker) stableSince(id raft.ServerID) (time.Time, bool) {
+	if t == nil {
+		return time.Time{}, true
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if _, ok := t.failing[id]; ok {
+		return time.Time{}, false
+	}
+	if r, ok := t.resumed[id]; ok && r.After(t.leaderSince) {
+		return r, true
+	}
+	return t.leaderSince, true
+}
+
+// failingSince returns when this leader last heard from the peer if it fails to heartbeat it. The time is zero if raft doesn't know.
+func (t *heartbeatTracker) failingSince(id raft.ServerID) (time.Time, bool) {
+	if t == nil {
+		return time.Time{}, false
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	lc, ok := t.failing[id]
+	return lc, ok
+}
+
+func (a *admin) PeerLastContact(ctx context.Context, req *pb.PeerLastContactRequest) (*pb.PeerLastContactResponse, error) {
+	if a.r.State() != raft.Leader {
+		return nil, a.notLeaderError("PeerLastContact")
+	}
+	cf := a.r.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, err
+	}
+	_, self := a.r.LeaderWithID()
+	ctx, cancel := context.WithTimeout(ctx, peerLastContactTimeout)
+	defer cancel()
+	ret := &pb.PeerLastContactResponse{}
+	var wg sync.WaitGroup
+	for _, s := range cf.Configuration().Servers {
+		if s.ID == self {
+			continue
+		}
+		p := &pb.PeerLastContactResponse_Peer{
+			Id:      string(s.ID),
+			Address: string(s.Address),
+		}
+		ret.Peers = append(ret.Peers, p)
+		if lc, failing := a.heartbeats.failingSince(s.ID); failing {
+			// raft tells us when it last heard from peers it fails to heartbeat.
+			p.HeartbeatFailing = true
+			if !lc.IsZero() {
+				p.LastContactUnixNano = lc.UnixNano()
+			}
+			continue
+		}
+		if a.peerDialOptions == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(s raft.Server, p *pb.PeerLastContactResponse_Peer) {
+			defer wg.Done()
+			p.LastContactUnixNano = a.peerLastContact(ctx, s)
+		}(s, p)
+	}
+	wg.Wait()
+	return ret, nil
+}
+
+// peerLastContact asks a follower when it last heard from the leader, and returns that time, or 0 if it can't be reached or never heard from the leader.
+func (a *admin) peerLastContact(ctx context.Context, s raft.Server) int64 {
+	c, err := a.peer(s.Address)
+	if err != nil {
+		return 0
+	}
+	now := time.Now()
+	st, err := c.Stats(ctx, &pb.StatsRequest{})
+	if err != nil {
+		return 0
+	}
+	d, err := parseLastContact(st.GetStats()["last_contact"])
+	if err != nil || d < 0 {
+		return 0
+	}
+	return now.Add(-time.Duration(d)).UnixNano()
+}
@@ -0,0 +1,123 @@
+package raftadmin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statsHistory keeps the last samples of the stats of this node in a ring buffer.
+type statsHistory struct {
+	interval time.Duration
+
+	mtx     sync.Mutex
+	samples []*pb.StatsHistoryResponse_Sample
+	// next is where the next sample goes. Once the buffer is full, it is also the oldest sample.
+	next int
+	full bool
+}
+
+func newStatsHistory(interval time.Duration, size int) *statsHistory {
+	return &statsHistory{
+		interval: interval,
+		samples:  make([]*pb.StatsHistoryResponse_Sample, size),
+	}
+}
+
+// run samples the stats every interval until raft is shut down or stop is closed.
+func (h *statsHistory) run(r Raft, stop <-chan struct{}) {
+	t := time.NewTicker(h.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+		s, err := sampleStats(r)
+		if err != nil {
+			continue
+		}
+		h.mtx.Lock()
+		h.samples[h.next] = s
+		h.next = (h.next + 1) % len(h.samples)
+		if h.next == 0 {
+			h.full = true
+		}
+		h.mtx.Unlock()
+		if s.GetState() == pb.StateResponse_SHUTDOWN {
+			return
+		}
+	}
+}
+
+// between returns the samples taken between since and until (inclusive), oldest first. Zero times are unbounded.
+func (h *statsHistory) between(since, until time.Time) []*pb.StatsHistoryResponse_Sample {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	ordered := h.samples[:h.next]
+	if h.full {
+		ordered = append(append([]*pb.StatsHistoryResponse_Sample{}, h.samples[h.next:]...), h.samples[:h.next]...)
+	}
+	var ret []*pb.StatsHistoryResponse_Sample
+	for _, s := range ordered {
+		t := time.Unix(0, s.GetTimeUnixNano())
+		if (!since.IsZero() && t.Before(since)) || (!until.IsZero() && t.After(until)) {
+			continue
+		}
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+func sampleStats(r Raft) (*pb.StatsHistoryResponse_Sample, error) {
+	stats := r.Stats()
+	ret := &pb.StatsHistoryResponse_Sample{
+		TimeUnixNano: time.Now().UnixNano(),
+	}
+	var err error
+	if ret.State, err = toState(r.State()); err != nil {
+		return nil, err
+	}
+	for k, f := range map[string]*uint64{
+		"term":                &ret.Term,
+		"last_log_index":      &ret.LastLogIndex,
+		"commit_index":        &ret.CommitIndex,
+		"applied_index":       &ret.AppliedIndex,
+		"fsm_pending":         &ret.FsmPending,
+		"last_snapshot_index": &ret.LastSnapshotIndex,
+	} {
+		if *f, err = strconv.ParseUint(stats[k], 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", k, err)
+		}
+	}
+	if ret.LastContactNanos, err = parseLastContact(stats["last_contact"]); err != nil {
+		return nil, err
+	}
+	_, id := r.LeaderWithID()
+	ret.LeaderId = string(id)
+	return ret, nil
+}
+
+func (a *admin) StatsHistory(ctx context.Context, req *pb.StatsHistoryRequest) (*pb.StatsHistoryResponse, error) {
+	if a.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "StatsHistory requires raftadmin.WithStatsHistory")
+	}
+	var since, until time.Time
+	if req.GetSinceUnixNano() != 0 {
+		since = time.Unix(0, req.GetSinceUnixNano())
+	}
+	if req.GetUntilUnixNano() != 0 {
+		until = time.Unix(0, req.GetUntilUnixNano())
+	}
+	return &pb.StatsHistoryResponse{
+		IntervalNanos: int64(a.history.interval),
+		Samples:       a.history.between(since, until),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package raftadmin
+
+import (
+	"context"
+	"net/http"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+// NewHTTPHandler returns an http.Handler that serves the RaftAdmin service as REST/JSON, like GET /v1/configuration and POST /v1/add_voter, by calling it over conn.
+// Methods that don't change anything are GETs that take the request fields as query parameters, the others are POSTs that take the request as JSON body. proto/raftadmin_http.yaml lists all paths. ApplyStream, RestoreSnapshot and DownloadSnapshot are only available over gRPC.
+// The Authorization header is passed on, and so is other metadata in Grpc-Metadata- headers, like Grpc-Metadata-Raftadmin-Cluster. Dial conn with LeaderServiceConfig to send calls to the leader.
+func NewHTTPHandler(conn *grpc.ClientConn) http.Handler {
+	mux := runtime.NewServeMux()
+	if err := pb.RegisterRaftAdminHandler(context.Background(), mux, conn); err != nil {
+		// It only fails when registering a path twice.
+		panic(err)
+	}
+	return mux
+}
@@ -0,0 +1,220 @@
+package raftadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// readOnlyMethods are the RPCs allowed by WithReadOnly: those of the RaftInspect service. They don't change any state, and don't return the data in the log or snapshots.
+var readOnlyMethods = func() map[string]bool {
+	d := pb.RaftInspectServiceDesc()
+	ret := map[string]bool{}
+	for _, m := range d.Methods {
+		ret[m.MethodName] = true
+	}
+	for _, s := range d.Streams {
+		ret[s.StreamName] = true
+	}
+	return ret
+}()
+
+// serviceDesc returns the description of the RaftAdmin service with every handler wrapped, so calls pass through interceptUnary and interceptStream.
+func (a *admin) serviceDesc() *grpc.ServiceDesc {
+	return a.wrapServiceDesc(pb.RaftAdminServiceDesc())
+}
+
+// inspectServiceDesc returns the description of the RaftInspect service, wrapped like serviceDesc.
+func (a *admin) inspectServiceDesc() *grpc.ServiceDesc {
+	return a.wrapServiceDesc(pb.RaftInspectServiceDesc())
+}
+
+// wrapServiceDesc wraps every handler of d, which are those of the RaftAdmin service, so calls pass through interceptUnary and interceptStream.
+// The generated code lets us wrap handlers of unary calls with an interceptor, but we have to wrap those of streaming calls ourselves.
+func (a *admin) wrapServiceDesc(d grpc.ServiceDesc) *grpc.ServiceDesc {
+	for i, m := range d.Methods {
+		h := m.Handler
+		fullMethod := "/" + d.ServiceName + "/" + m.MethodName
+		d.Methods[i].Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			// Run after the interceptors of the grpc.Server, so we see what they put in the context (like the authenticated caller).
+			return h(srv, ctx, dec, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				// The generated handler always says it's a RaftAdmin method.
+				info = &grpc.UnaryServerInfo{Server: info.Server, FullMethod: fullMethod}
+				inner := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return a.interceptUnary(ctx, req, info, handler)
+				}
+				if interceptor == nil {
+					return inner(ctx, req)
+				}
+				return interceptor(ctx, req, info, inner)
+			})
+		}
+	}
+	for i, s := range d.Streams {
+		h := s.Handler
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + d.ServiceName + "/" + s.StreamName,
+			IsClientStream: s.ClientStreams,
+			IsServerStream: s.ServerStreams,
+		}
+		d.Streams[i].Handler = func(srv interface{}, stream grpc.ServerStream) error {
+			return a.interceptStream(srv, stream, info, h)
+		}
+	}
+	return &d
+}
+
+func (a *admin) interceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := path.Base(info.FullMethod)
+	if _, ok := ctx.Deadline(); !ok {
+		d := a.defaultTimeout
+		if t, ok := a.methodTimeouts[method]; ok {
+			d = t
+		}
+		if d > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+	}
+	start := time.Now()
+	ctx, span := a.startSpan(ctx, info.FullMethod, method)
+	op := a.operations.start(ctx, info.FullMethod, req)
+	m, _ := req.(proto.Message)
+	var resp interface{}
+	err := a.checkCall(ctx, method, m)
+	if err == nil {
+		err = a.validateRequest(req)
+	}
+	rejected := err != nil
+	if !rejected {
+		if addr, ok := a.forwardTarget(ctx, method, req); ok {
+			resp, err = a.forward(ctx, addr, info.FullMethod, method, req)
+		} else if err = a.checkLeader(method); err == nil {
+			resp, err = handler(ctx, req)
+		}
+		if errors.Is(err, raft.ErrNotLeader) {
+			err = a.notLeaderError(method)
+		}
+	}
+	a.operations.finish(op, err)
+	a.logCall(ctx, method, start, rejected, err)
+	a.audit(ctx, start, method, m, err)
+	a.metrics.observe(method, start, err)
+	endSpan(span, err)
+	return resp, err
+}
+
+func (a *admin) interceptStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	method := path.Base(info.FullMethod)
+	ctx, span := a.startSpan(stream.Context(), info.FullMethod, method)
+	stream = tracedStream{stream, ctx}
+	op := a.operations.start(stream.Context(), info.FullMethod, nil)
+	err := a.checkCall(stream.Context(), method, nil)
+	rejected := err != nil
+	if !rejected {
+		if err = a.checkLeader(method); err == nil {
+			err = handler(srv, stream)
+		}
+		if errors.Is(err, raft.ErrNotLeader) {
+			err = a.notLeaderError(method)
+		}
+	}
+	a.operations.finish(op, err)
+	a.logCall(stream.Context(), method, start, rejected, err)
+	a.audit(stream.Context(), start, method, nil, err)
+	a.metrics.observe(method, start, err)
+	endSpan(span, err)
+	return err
+}
+
+// checkCall returns an error if the call isn't allowed by WithAuthToken, WithCertificateRules, WithPolicyFile, WithAuthorizer, WithAllowedMethods, WithDisabledMethods, WithReadOnly, one of the hooks or WithRateLimit.
+func (a *admin) checkCall(ctx context.Context, method string, req proto.Message) error {
+	if a.tokenVerifier != nil {
+		if err := a.checkToken(ctx); err != nil {
+			return err
+		}
+	}
+	if a.certificateRules != nil {
+		if err := a.checkCertificate(ctx, method); err != nil {
+			return err
+		}
+	}
+	if a.policy != nil {
+		if err := a.checkPolicy(ctx, method); err != nil {
+			return err
+		}
+	}
+	if a.authorizer != nil {
+		if err := a.authorizer(ctx, method, req); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return err
+			}
+			return status.Errorf(codes.PermissionDenied, "%s denied: %v", method, err)
+		}
+	}
+	if a.allowedMethods != nil && !a.allowedMethods[method] {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed on this server", method)
+	}
+	if a.disabledMethods[method] {
+		return status.Errorf(codes.PermissionDenied, "%s is disabled on this server", method)
+	}
+	if a.readOnly && !isReadOnlyMethod(method) {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed on this read-only server", method)
+	}
+	for _, h := range a.hooks {
+		if err := h(ctx, method, req); err != nil {
+			return err
+		}
+	}
+	// Rate limits come last, so calls that are rejected anyway don't use up the budget.
+	for _, l := range a.rateLimits {
+		if err := l.allow(method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logCall logs a finished call to the logger set by WithLogger. rejected means the call was refused by checkCall or validateRequest and never reached the handler.
+func (a *admin) logCall(ctx context.Context, method string, start time.Time, rejected bool, err error) {
+	if a.logger == nil {
+		return
+	}
+	switch {
+	case rejected:
+		a.logger.Warn("raftadmin call rejected", "method", method, "caller", callerOf(ctx), "code", status.Code(err), "error", err)
+	case err != nil:
+		a.logger.Warn("raftadmin call failed", "method", method, "caller", callerOf(ctx), "duration", time.Since(start), "code", status.Code(err), "error", err)
+	default:
+		a.logger.Debug("raftadmin call handled", "method", method, "caller", callerOf(ctx), "duration", time.Since(start))
+	}
+}
+
+// mustBeMethod panics if method isn't an RPC of the RaftAdmin service or an extension, to catch typos in options.
+func mustBeMethod(option, method string) {
+	if !isMethod(method) {
+		panic(fmt.Errorf("%s: RaftAdmin has no method or extension %q", option, method))
+	}
+}
+
+// isMethod returns whether method is an RPC of the RaftAdmin service or an extension added with WithExtension.
+func isMethod(method string) bool {
+	return isRaftAdminMethod(method) || isExtension(method)
+}
+
+// isRaftAdminMethod returns whether method is an RPC of the RaftAdmin service.
+func isRaftAdminMethod(method string) bool {
+	return pb.File_raftadmin_proto.Services().ByName("RaftAdmin").Methods().ByName(protoreflect.Name(method)) != nil
+}
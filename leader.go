@@ -0,0 +1,41 @@
+package raftadmin
+
+import (
+	"fmt"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// notLeaderError returns a FAILED_PRECONDITION error saying that method must be sent to the leader.
+// It carries the ID and address of the current leader (if known) in an ErrorInfo detail, which clients can read with pb.LeaderFromError.
+func (a *admin) notLeaderError(method string) error {
+	addr, id := a.r.LeaderWithID()
+	msg := fmt.Sprintf("%s must be sent to the leader, which is %s (%s)", method, id, addr)
+	if addr == "" {
+		msg = fmt.Sprintf("%s must be sent to the leader, and there is none right now", method)
+	}
+	st, err := status.New(codes.FailedPrecondition, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason: pb.NotLeaderReason,
+		Domain: pb.ErrorDomain,
+		Metadata: map[string]string{
+			"leader_id":      string(id),
+			"leader_address": string(addr),
+		},
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	return st.Err()
+}
+
+// checkLeader returns a notLeaderError if WithRequireLeader is set, this node isn't the leader and method only works on the leader.
+func (a *admin) checkLeader(method string) error {
+	if !a.requireLeader || (!leaderMethods[method] && method != "ApplyStream") || a.r.State() == raft.Leader {
+		return nil
+	}
+	return a.notLeaderError(method)
+}
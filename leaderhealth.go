@@ -0,0 +1,64 @@
+package raftadmin
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// LeaderHealthService is the health service that raftadmin --leader checks by default. It's the same name https://github.com/Jille/raft-grpc-leader-rpc uses.
+const LeaderHealthService = "quis.RaftLeader"
+
+// LeaderServiceConfig returns the gRPC service config for clients that should only talk to the leader. It health checks serviceName, like LeaderHealthService, on every node and sends calls to the one that reports SERVING.
+// Pass it to grpc.WithDefaultServiceConfig when dialing all nodes, for example with multi:///host1:port,host2:port. The client must import google.golang.org/grpc/health to enable health checking.
+func LeaderServiceConfig(serviceName string) string {
+	name, _ := json.Marshal(serviceName)
+	return fmt.Sprintf(`{"healthCheckConfig": {"serviceName": %s}, "loadBalancingConfig": [{"round_robin": {}}]}`, name)
+}
+
+// RegisterLeaderHealth registers the standard gRPC health service on gs, and reports the given services as SERVING while this node is the raft leader and NOT_SERVING otherwise. Without services, it reports LeaderHealthService.
+// Clients that health check one of those services, like raftadmin --leader, then only send calls to the leader.
+// It returns the health server, so you can set the status of other services on it. Close stops tracking leadership and sets all services to NOT_SERVING.
+func (s *Server) RegisterLeaderHealth(gs grpc.ServiceRegistrar, services ...string) *health.Server {
+	a := s.a
+	if len(services) == 0 {
+		services = []string{LeaderHealthService}
+	}
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(gs, hs)
+
+	ch := make(chan raft.Observation, 16)
+	o := raft.NewObserver(ch, false, func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.RaftState)
+		return ok
+	})
+	a.r.RegisterObserver(o)
+	update := func() {
+		st := healthpb.HealthCheckResponse_NOT_SERVING
+		if a.r.State() == raft.Leader {
+			st = healthpb.HealthCheckResponse_SERVING
+		}
+		for _, svc := range services {
+			hs.SetServingStatus(svc, st)
+		}
+	}
+	update()
+	go func() {
+		defer a.r.DeregisterObserver(o)
+		for {
+			select {
+			case <-ch:
+				// Observations are dropped while ch is full, and the ones still queued are handled after the dropped change. Asking raft for the state rather than using the observed one catches up.
+				update()
+			case <-a.closed:
+				hs.Shutdown()
+				return
+			}
+		}
+	}()
+	return hs
+}
@@ -0,0 +1,76 @@
+package raftadmin
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+)
+
+// gracefulStopTimeout is how long ListenAndServe waits for calls to finish when it stops, before closing their connections.
+const gracefulStopTimeout = 10 * time.Second
+
+// ListenAndServe serves the RaftAdmin service for r on a gRPC server of its own, listening on addr, like "127.0.0.1:7001". Use it to keep the admin API off the application's main gRPC port.
+// The gRPC server also serves reflection, which raftadmin needs for --reflection and extensions, and the health of LeaderHealthService (see RegisterLeaderHealth) for raftadmin --leader. It pings idle clients so dead connections get cleaned up.
+// WithTLSConfig makes it serve TLS, and WithGRPCServerOptions adds options to the gRPC server.
+// It returns when serving fails, or once the context passed with WithServeContext is done. Then it drains the server (see Server.Drain) and lets calls finish for up to 10 seconds, and returns nil.
+func ListenAndServe(addr string, r Raft, opts ...Option) error {
+	srv := New(r, opts...)
+	a := srv.a
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		srv.Close()
+		return err
+	}
+	sopts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{Time: time.Minute}),
+		// raftadmin --watch and Observe keep streams open without sending anything for long periods.
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: 10 * time.Second, PermitWithoutStream: true}),
+	}
+	if a.tlsConfig != nil {
+		sopts = append(sopts, grpc.Creds(credentials.NewTLS(a.tlsConfig)))
+	}
+	gs := grpc.NewServer(append(sopts, a.grpcServerOptions...)...)
+	srv.Register(gs)
+	srv.RegisterLeaderHealth(gs)
+	reflection.Register(gs)
+
+	ctx := a.serveContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	served := make(chan error, 1)
+	go func() {
+		served <- gs.Serve(lis)
+	}()
+	select {
+	case err := <-served:
+		srv.Close()
+		return err
+	case <-ctx.Done():
+	}
+
+	if a.logger != nil {
+		a.logger.Info("raftadmin stopping", "address", lis.Addr())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gracefulStopTimeout)
+	defer cancel()
+	_ = srv.Drain(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		gs.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		// Streams like WatchLeader never end by themselves.
+		gs.Stop()
+		<-stopped
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package raftadmin
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *admin) DeleteLogRange(ctx context.Context, req *pb.DeleteLogRangeRequest) (*pb.DeleteLogRangeResponse, error) {
+	if a.logRepairSecret == nil {
+		return nil, status.Error(codes.FailedPrecondition, "DeleteLogRange is disabled; use raftadmin.WithLogRepair to enable it")
+	}
+	if a.logs == nil {
+		return nil, status.Error(codes.FailedPrecondition, "DeleteLogRange requires the LogStore to be passed with raftadmin.WithLogStore")
+	}
+	if err := a.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	if s := a.r.State(); s != raft.Shutdown {
+		return nil, status.Errorf(codes.FailedPrecondition, "DeleteLogRange only works after raft was shut down (e.g. with the Shutdown RPC), but this node is %s", s)
+	}
+	if req.GetMinIndex() == 0 || req.GetMaxIndex() < req.GetMinIndex() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid range %d-%d", req.GetMinIndex(), req.GetMaxIndex())
+	}
+	first, err := a.logs.FirstIndex()
+	if err != nil {
+		return nil, err
+	}
+	last, err := a.logs.LastIndex()
+	if err != nil {
+		return nil, err
+	}
+	ret := &pb.DeleteLogRangeResponse{
+		FirstIndex: first,
+		LastIndex:  last,
+	}
+	// The token is tied to the range and the current state of the log, so a token from an earlier call can't delete anything unexpected.
+	token := a.logRepairToken(req.GetMinIndex(), req.GetMaxIndex(), first, last)
+	if req.GetConfirmationToken() == "" {
+		ret.ConfirmationToken = token
+		return ret, nil
+	}
+	if !hmac.Equal([]byte(req.GetConfirmationToken()), []byte(token)) {
+		return nil, status.Error(codes.FailedPrecondition, "confirmation_token doesn't match this range or the log changed; call DeleteLogRange without a token to get a new one")
+	}
+	if err := a.logs.DeleteRange(req.GetMinIndex(), req.GetMaxIndex()); err != nil {
+		return nil, err
+	}
+	ret.Deleted = true
+	if ret.FirstIndex, err = a.logs.FirstIndex(); err != nil {
+		return nil, err
+	}
+	if ret.LastIndex, err = a.logs.LastIndex(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// logRepairToken returns the confirmation token for deleting min-max from a log that currently spans first-last.
+func (a *admin) logRepairToken(min, max, first, last uint64) string {
+	h := hmac.New(sha256.New, a.logRepairSecret)
+	fmt.Fprintf(h, "%d-%d-%d-%d", min, max, first, last)
+	return fmt.Sprintf("%x", h.Sum(nil)[:8])
+}
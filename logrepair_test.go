@@ -0,0 +1,70 @@
+package raftadmin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDeleteLogRange(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	leader := bootstrap(t, nodes...)
+	for i := 0; i < 3; i++ {
+		if err := leader.r.Apply([]byte("entry"), time.Second).Error(); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+	}
+	ctx := context.Background()
+
+	_, err := newTestServer(t, leader.r, WithLogStore(leader.store)).a.DeleteLogRange(ctx, &pb.DeleteLogRangeRequest{MinIndex: 1, MaxIndex: 1})
+	wantCode(t, err, codes.FailedPrecondition, "WithLogRepair")
+	_, err = newTestServer(t, leader.r, WithLogRepair()).a.DeleteLogRange(ctx, &pb.DeleteLogRangeRequest{MinIndex: 1, MaxIndex: 1})
+	wantCode(t, err, codes.FailedPrecondition, "WithLogStore")
+
+	s := newTestServer(t, leader.r, WithLogRepair(), WithLogStore(leader.store))
+	last, err := leader.store.LastIndex()
+	if err != nil {
+		t.Fatalf("LastIndex failed: %v", err)
+	}
+	req := &pb.DeleteLogRangeRequest{MinIndex: last, MaxIndex: last}
+	_, err = s.a.DeleteLogRange(ctx, req)
+	wantCode(t, err, codes.FailedPrecondition, "only works after raft was shut down")
+
+	if err := leader.r.Shutdown().Error(); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	_, err = s.a.DeleteLogRange(ctx, &pb.DeleteLogRangeRequest{MinIndex: last, MaxIndex: last - 1})
+	wantCode(t, err, codes.InvalidArgument, "invalid range")
+
+	resp, err := s.a.DeleteLogRange(ctx, req)
+	if err != nil {
+		t.Fatalf("DeleteLogRange without a token failed: %v", err)
+	}
+	if resp.GetDeleted() || resp.GetConfirmationToken() == "" || resp.GetFirstIndex() != 1 || resp.GetLastIndex() != last {
+		t.Fatalf("DeleteLogRange without a token returned %v, want a token and the log spanning 1-%d", resp, last)
+	}
+	token := resp.GetConfirmationToken()
+
+	// The token is only good for the range it was given for.
+	_, err = s.a.DeleteLogRange(ctx, &pb.DeleteLogRangeRequest{MinIndex: last - 1, MaxIndex: last, ConfirmationToken: token})
+	wantCode(t, err, codes.FailedPrecondition, "doesn't match")
+
+	req.ConfirmationToken = token
+	resp, err = s.a.DeleteLogRange(ctx, req)
+	if err != nil {
+		t.Fatalf("DeleteLogRange with a token failed: %v", err)
+	}
+	if !resp.GetDeleted() || resp.GetLastIndex() != last-1 {
+		t.Errorf("DeleteLogRange with a token returned %v, want the log to end at %d", resp, last-1)
+	}
+	if got, err := leader.store.LastIndex(); err != nil || got != last-1 {
+		t.Errorf("LastIndex after DeleteLogRange is %d (%v), want %d", got, err, last-1)
+	}
+
+	// The log changed, so the token doesn't work again.
+	_, err = s.a.DeleteLogRange(ctx, req)
+	wantCode(t, err, codes.FailedPrecondition, "doesn't match")
+}
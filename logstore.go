@@ -0,0 +1,87 @@
+package raftadmin
+
+import (
+	"context"
+	"reflect"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LogStoreStats describes the storage used by a LogStore. Fields that don't apply to a store are left zero.
+type LogStoreStats struct {
+	// SizeBytes is the on-disk size of the store.
+	SizeBytes int64
+	// FreePages and PendingPages are the number of pages that are free, or that will be free once no transaction uses them anymore.
+	FreePages    int64
+	PendingPages int64
+	// FreeAllocBytes is the number of bytes allocated in free pages.
+	FreeAllocBytes int64
+	// FreelistInuseBytes is the number of bytes used by the freelist.
+	FreelistInuseBytes int64
+}
+
+// LogStoreStatser can be implemented by a LogStore (or a wrapper around one) to report its storage statistics in LogStoreStats.
+// Stores from github.com/hashicorp/raft-boltdb don't need to implement it for their page statistics, but do for SizeBytes.
+type LogStoreStatser interface {
+	LogStoreStats() (LogStoreStats, error)
+}
+
+func (a *admin) LogStoreStats(ctx context.Context, req *pb.LogStoreStatsRequest) (*pb.LogStoreStatsResponse, error) {
+	if a.logs == nil {
+		return nil, status.Error(codes.FailedPrecondition, "LogStoreStats requires the LogStore to be passed with raftadmin.WithLogStore")
+	}
+	ret := &pb.LogStoreStatsResponse{}
+	var err error
+	if ret.FirstIndex, err = a.logs.FirstIndex(); err != nil {
+		return nil, err
+	}
+	if ret.LastIndex, err = a.logs.LastIndex(); err != nil {
+		return nil, err
+	}
+	if s, ok := a.logs.(LogStoreStatser); ok {
+		st, err := s.LogStoreStats()
+		if err != nil {
+			return nil, err
+		}
+		ret.Storage = toStorageStats(st)
+	} else if st, ok := boltStats(a.logs); ok {
+		ret.Storage = toStorageStats(st)
+	}
+	return ret, nil
+}
+
+func toStorageStats(st LogStoreStats) *pb.LogStoreStatsResponse_Storage {
+	return &pb.LogStoreStatsResponse_Storage{
+		SizeBytes:          st.SizeBytes,
+		FreePages:          st.FreePages,
+		PendingPages:       st.PendingPages,
+		FreeAllocBytes:     st.FreeAllocBytes,
+		FreelistInuseBytes: st.FreelistInuseBytes,
+	}
+}
+
+// boltStats gets the page statistics of a raft-boltdb store, which has a Stats method returning bolt.Stats (or bbolt.Stats for v2).
+// It uses reflection so we don't have to depend on either.
+func boltStats(store interface{}) (LogStoreStats, bool) {
+	m := reflect.ValueOf(store).MethodByName("Stats")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Struct {
+		return LogStoreStats{}, false
+	}
+	v := m.Call(nil)[0]
+	var ret LogStoreStats
+	found := false
+	for name, f := range map[string]*int64{
+		"FreePageN":     &ret.FreePages,
+		"PendingPageN":  &ret.PendingPages,
+		"FreeAlloc":     &ret.FreeAllocBytes,
+		"FreelistInuse": &ret.FreelistInuseBytes,
+	} {
+		if fv := v.FieldByName(name); fv.IsValid() && fv.CanInt() {
+			*f = fv.Int()
+			found = true
+		}
+	}
+	return ret, found
+}
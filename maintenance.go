@@ -0,0 +1,55 @@
+package raftadmin
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maintenanceMode is set by EnterMaintenance. It only lives in memory, so restarting the node lifts it.
+type maintenanceMode struct {
+	mtx     sync.Mutex
+	enabled bool
+	reason  string
+	since   time.Time
+}
+
+func (a *admin) EnterMaintenance(ctx context.Context, req *pb.EnterMaintenanceRequest) (*pb.EnterMaintenanceResponse, error) {
+	a.maintenance.mtx.Lock()
+	defer a.maintenance.mtx.Unlock()
+	if !a.maintenance.enabled {
+		a.maintenance.enabled = true
+		a.maintenance.since = time.Now()
+	}
+	a.maintenance.reason = req.GetReason()
+	return &pb.EnterMaintenanceResponse{}, nil
+}
+
+func (a *admin) ExitMaintenance(ctx context.Context, req *pb.ExitMaintenanceRequest) (*pb.ExitMaintenanceResponse, error) {
+	a.maintenance.mtx.Lock()
+	defer a.maintenance.mtx.Unlock()
+	a.maintenance.enabled = false
+	a.maintenance.reason = ""
+	return &pb.ExitMaintenanceResponse{}, nil
+}
+
+// checkMaintenance returns an error if this node is in maintenance mode. Destructive RPCs call it before doing anything.
+func (a *admin) checkMaintenance(ctx context.Context) error {
+	a.maintenance.mtx.Lock()
+	defer a.maintenance.mtx.Unlock()
+	if !a.maintenance.enabled {
+		return nil
+	}
+	method, _ := grpc.Method(ctx)
+	method = path.Base(method)
+	if a.maintenance.reason == "" {
+		return status.Errorf(codes.FailedPrecondition, "%s is not allowed: node is in maintenance mode since %s (use ExitMaintenance to lift it)", method, a.maintenance.since.Format(time.RFC3339))
+	}
+	return status.Errorf(codes.FailedPrecondition, "%s is not allowed: node is in maintenance mode since %s: %s (use ExitMaintenance to lift it)", method, a.maintenance.since.Format(time.RFC3339), a.maintenance.reason)
+}
@@ -0,0 +1,39 @@
+package raftadmin
+
+import (
+	"github.com/hashicorp/raft"
+)
+
+// presentFuture is the result of adding a server that was already in the configuration, with WithIdempotentAdds. It is done right away, and its index is that of the configuration that already contains the server, as nothing was appended to the log.
+type presentFuture struct {
+	index uint64
+}
+
+func (presentFuture) Error() error {
+	return nil
+}
+
+func (f presentFuture) Index() uint64 {
+	return f.index
+}
+
+// alreadyPresent returns a presentFuture if WithIdempotentAdds is set, this node is the leader and the server is in the configuration with the given address and suffrage.
+// Otherwise the server must be added by raft, which fails on followers like it would without WithIdempotentAdds.
+func (a *admin) alreadyPresent(id, address string, suffrage raft.ServerSuffrage) (raft.IndexFuture, bool) {
+	if !a.idempotentAdds || a.r.State() != raft.Leader {
+		return nil, false
+	}
+	cf := a.r.GetConfiguration()
+	if cf.Error() != nil {
+		return nil, false
+	}
+	for _, s := range cf.Configuration().Servers {
+		if s.ID == raft.ServerID(id) && s.Address == raft.ServerAddress(address) && s.Suffrage == suffrage {
+			if a.logger != nil {
+				a.logger.Debug("raftadmin didn't add server that is already present", "id", id, "address", address, "suffrage", suffrage)
+			}
+			return presentFuture{index: a.configurationIndex(cf.Index())}, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,79 @@
+package raftadmin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+)
+
+func (a *admin) GetMetadata(ctx context.Context, req *pb.GetMetadataRequest) (*pb.GetMetadataResponse, error) {
+	return &pb.GetMetadataResponse{
+		Metadata: a.copyMetadata(),
+	}, nil
+}
+
+func (a *admin) SetMetadata(ctx context.Context, req *pb.SetMetadataRequest) (*pb.SetMetadataResponse, error) {
+	a.metadataMtx.Lock()
+	if req.GetReplace() || a.metadata == nil {
+		a.metadata = map[string]string{}
+	}
+	for k, v := range req.GetMetadata() {
+		if v == "" {
+			delete(a.metadata, k)
+		} else {
+			a.metadata[k] = v
+		}
+	}
+	a.metadataMtx.Unlock()
+	return &pb.SetMetadataResponse{
+		Metadata: a.copyMetadata(),
+	}, nil
+}
+
+// copyMetadata returns a copy of the tags of this node, which the caller may modify.
+func (a *admin) copyMetadata() map[string]string {
+	a.metadataMtx.Lock()
+	defer a.metadataMtx.Unlock()
+	ret := make(map[string]string, len(a.metadata))
+	for k, v := range a.metadata {
+		ret[k] = v
+	}
+	return ret
+}
+
+// peerMetadataTimeout bounds how long fillMetadata waits for other nodes, so one slow node doesn't hold up GetConfiguration.
+const peerMetadataTimeout = time.Second
+
+// fillMetadata sets the tags of the given servers. Those of other nodes are fetched in parallel if WithPeerDialOptions was given; nodes that can't be reached are left without tags.
+func (a *admin) fillMetadata(ctx context.Context, servers []*pb.GetConfigurationResponse_Server) {
+	ctx, cancel := context.WithTimeout(ctx, peerMetadataTimeout)
+	defer cancel()
+	local := a.localAddress()
+	var wg sync.WaitGroup
+	for _, s := range servers {
+		if s.GetAddress() == local {
+			s.Metadata = a.copyMetadata()
+			continue
+		}
+		if a.peerDialOptions == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(s *pb.GetConfigurationResponse_Server) {
+			defer wg.Done()
+			c, err := a.peer(raft.ServerAddress(s.GetAddress()))
+			if err != nil {
+				return
+			}
+			resp, err := c.GetMetadata(ctx, &pb.GetMetadataRequest{})
+			if err != nil {
+				return
+			}
+			s.Metadata = resp.GetMetadata()
+		}(s)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,118 @@
+package raftadmin
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/status"
+)
+
+// metrics instruments the calls to the server. It is a prometheus.Collector; see (*Server).Collector.
+type metrics struct {
+	calls         *prometheus.CounterVec
+	latency       *prometheus.HistogramVec
+	pending       prometheus.GaugeFunc
+	reapedFutures prometheus.Counter
+	leader        prometheus.GaugeFunc
+
+	mtx sync.Mutex
+	// leaderChanged is when this node last observed a change of leader, or zero if it didn't.
+	leaderChanged time.Time
+
+	observer *raft.Observer
+	ch       chan raft.Observation
+}
+
+// newMetrics creates the metrics of a. If it serves a cluster of Clusters, they get its ID as constant "cluster" label, so the servers of all clusters can be registered with the same registry.
+func newMetrics(a *admin) *metrics {
+	var labels prometheus.Labels
+	if a.clusterID != "" {
+		labels = prometheus.Labels{"cluster": a.clusterID}
+	}
+	m := &metrics{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "raftadmin_calls_total",
+			Help:        "The number of RaftAdmin calls handled, by method and status code.",
+			ConstLabels: labels,
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "raftadmin_call_duration_seconds",
+			Help:        "How long RaftAdmin calls took, by method.",
+			ConstLabels: labels,
+			Buckets:     prometheus.DefBuckets,
+		}, []string{"method"}),
+		reapedFutures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name:        "raftadmin_reaped_futures_total",
+			Help:        "The number of operations that were forgotten because nobody awaited or forgot them within the TTL.",
+			ConstLabels: labels,
+		}),
+	}
+	m.pending = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "raftadmin_pending_futures",
+		Help:        "The number of operations the server holds on to until they are awaited or forgotten.",
+		ConstLabels: labels,
+	}, func() float64 {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return float64(atomic.LoadInt32(&a.numFutures))
+	})
+	m.leader = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "raftadmin_last_leader_change_timestamp_seconds",
+		Help:        "When this node last saw the leader change, as a Unix timestamp. 0 if it didn't since it started.",
+		ConstLabels: labels,
+	}, func() float64 {
+		m.mtx.Lock()
+		defer m.mtx.Unlock()
+		if m.leaderChanged.IsZero() {
+			return 0
+		}
+		return float64(m.leaderChanged.UnixNano()) / 1e9
+	})
+
+	m.ch = make(chan raft.Observation, 16)
+	m.observer = raft.NewObserver(m.ch, false, func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.LeaderObservation)
+		return ok
+	})
+	a.r.RegisterObserver(m.observer)
+	go func() {
+		for range m.ch {
+			m.mtx.Lock()
+			m.leaderChanged = time.Now()
+			m.mtx.Unlock()
+		}
+	}()
+	return m
+}
+
+// stop stops watching for leader changes.
+func (m *metrics) stop(r Raft) {
+	r.DeregisterObserver(m.observer)
+	// Raft no longer sends on ch once the observer is deregistered.
+	close(m.ch)
+}
+
+// observe records a finished call.
+func (m *metrics) observe(method string, start time.Time, err error) {
+	m.calls.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.latency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.calls.Describe(ch)
+	m.latency.Describe(ch)
+	m.pending.Describe(ch)
+	m.reapedFutures.Describe(ch)
+	m.leader.Describe(ch)
+}
+
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.calls.Collect(ch)
+	m.latency.Collect(ch)
+	m.pending.Collect(ch)
+	m.reapedFutures.Collect(ch)
+	m.leader.Collect(ch)
+}
@@ -0,0 +1,149 @@
+package raftadmin
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxOperationRequestSize is how much of the request is kept for each operation.
+const maxOperationRequestSize = 256
+
+// operationsLog keeps the last RPCs handled by the admin server in a ring buffer.
+type operationsLog struct {
+	mtx sync.Mutex
+	ops []*pb.ListOperationsResponse_Operation
+	// next is where the next operation goes. Once the buffer is full, it is also the oldest operation.
+	next int
+}
+
+func newOperationsLog(size int) *operationsLog {
+	return &operationsLog{
+		ops: make([]*pb.ListOperationsResponse_Operation, size),
+	}
+}
+
+// start records the start of an RPC. req is nil for streaming RPCs. It is a no-op on a nil operationsLog.
+func (l *operationsLog) start(ctx context.Context, fullMethod string, req interface{}) *pb.ListOperationsResponse_Operation {
+	if l == nil {
+		return nil
+	}
+	op := &pb.ListOperationsResponse_Operation{
+		StartUnixNano: time.Now().UnixNano(),
+		Method:        path.Base(fullMethod),
+		Caller:        callerOf(ctx),
+		Request:       "(stream)",
+	}
+	if m, ok := req.(proto.Message); ok {
+		m = proto.Clone(m)
+		redactBytes(m.ProtoReflect())
+		op.Request = prototext.MarshalOptions{}.Format(m)
+		if len(op.Request) > maxOperationRequestSize {
+			op.Request = op.Request[:maxOperationRequestSize] + "..."
+		}
+	}
+	return op
+}
+
+// redactBytes replaces the value of every bytes field in m, like the data of ApplyLog, by its length. ListOperations is read-only, and read-only callers mustn't see what's in the log.
+func redactBytes(m protoreflect.Message) {
+	redact := func(b []byte) protoreflect.Value {
+		return protoreflect.ValueOfBytes([]byte(fmt.Sprintf("(%d bytes)", len(b))))
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				switch fd.MapValue().Kind() {
+				case protoreflect.BytesKind:
+					v.Map().Set(k, redact(mv.Bytes()))
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					redactBytes(mv.Message())
+				}
+				return true
+			})
+		case fd.IsList():
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				switch fd.Kind() {
+				case protoreflect.BytesKind:
+					l.Set(i, redact(l.Get(i).Bytes()))
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					redactBytes(l.Get(i).Message())
+				}
+			}
+		case fd.Kind() == protoreflect.BytesKind:
+			m.Set(fd, redact(v.Bytes()))
+		case fd.Kind() == protoreflect.MessageKind, fd.Kind() == protoreflect.GroupKind:
+			redactBytes(v.Message())
+		}
+		return true
+	})
+}
+
+// finish records the result of an RPC started with start.
+func (l *operationsLog) finish(op *pb.ListOperationsResponse_Operation, err error) {
+	if l == nil {
+		return
+	}
+	op.DurationNanos = time.Now().UnixNano() - op.GetStartUnixNano()
+	st := status.Convert(err)
+	op.Code = st.Code().String()
+	op.Error = st.Message()
+	l.mtx.Lock()
+	l.ops[l.next] = op
+	l.next = (l.next + 1) % len(l.ops)
+	l.mtx.Unlock()
+}
+
+// list returns the recorded operations for the given method (or all if empty), newest first, at most limit if it isn't 0.
+func (l *operationsLog) list(method string, limit int) []*pb.ListOperationsResponse_Operation {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	var ret []*pb.ListOperationsResponse_Operation
+	for i := 1; i <= len(l.ops); i++ {
+		op := l.ops[(l.next-i+len(l.ops))%len(l.ops)]
+		if op == nil {
+			break
+		}
+		if method != "" && op.GetMethod() != method {
+			continue
+		}
+		ret = append(ret, op)
+		if limit > 0 && len(ret) >= limit {
+			break
+		}
+	}
+	return ret
+}
+
+// callerOf describes who sent an RPC: the common name of their verified TLS client certificate (if any) and their address.
+func callerOf(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	if cert, ok := ClientCertificate(ctx); ok && cert.Subject.CommonName != "" {
+		return fmt.Sprintf("%s (%s)", cert.Subject.CommonName, p.Addr)
+	}
+	return p.Addr.String()
+}
+
+func (a *admin) ListOperations(ctx context.Context, req *pb.ListOperationsRequest) (*pb.ListOperationsResponse, error) {
+	if a.operations == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ListOperations requires raftadmin.WithOperationsLog")
+	}
+	return &pb.ListOperationsResponse{
+		Operations: a.operations.list(req.GetMethod(), int(req.GetLimit())),
+	}, nil
+}
@@ -0,0 +1,419 @@
+package raftadmin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// Option configures optional behavior of the RaftAdmin server.
+type Option func(*admin)
+
+// WithAllowedMethods makes the server reject calls to any RPC not listed, like "Stats", with PERMISSION_DENIED. It only works with New or Register.
+func WithAllowedMethods(methods ...string) Option {
+	return func(a *admin) {
+		a.allowedMethods = map[string]bool{}
+		for _, m := range methods {
+			mustBeMethod("WithAllowedMethods", m)
+			a.allowedMethods[m] = true
+		}
+	}
+}
+
+// WithApplyValidator makes ApplyLog, ApplyBatch and ApplyStream pass the data of every entry to validate before appending it to the log. Entries for which it returns an error are rejected.
+// Use this to keep malformed commands out of the log, where they would stay forever.
+func WithApplyValidator(validate func([]byte) error) Option {
+	return func(a *admin) {
+		a.applyValidator = validate
+	}
+}
+
+// WithAuditSink makes the server call sink after every call, with who made it, the request and how it ended, including calls that were rejected.
+// Use it to forward the calls to syslog or an audit pipeline. sink is called synchronously, so it should hand slow work off to another goroutine. It only works with New or Register.
+func WithAuditSink(sink func(AuditEvent)) Option {
+	return func(a *admin) {
+		a.auditSinks = append(a.auditSinks, sink)
+	}
+}
+
+// WithAuthToken makes the server reject calls with UNAUTHENTICATED unless they carry the given bearer token in their "authorization" metadata, like the CLI's --auth-token sends. It only works with New or Register.
+func WithAuthToken(token string) Option {
+	return WithAuthTokenVerifier(staticTokenVerifier(token))
+}
+
+// WithAuthTokenVerifier is like WithAuthToken, but calls verify to check the bearer token. If it returns an error, the call is rejected with UNAUTHENTICATED, unless the error is already a gRPC status.
+func WithAuthTokenVerifier(verify func(ctx context.Context, token string) error) Option {
+	return func(a *admin) {
+		a.tokenVerifier = verify
+	}
+}
+
+// WithAuthorizer makes the server call authorize before handling every call, with the method name (like "RemoveServer") and the request. req is nil for streaming RPCs.
+// If it returns an error, the call is rejected: with PERMISSION_DENIED, unless the error is already a gRPC status (e.g. UNAUTHENTICATED). It only works with New or Register.
+func WithAuthorizer(authorize func(ctx context.Context, method string, req proto.Message) error) Option {
+	return func(a *admin) {
+		a.authorizer = authorize
+	}
+}
+
+// WithCertificateRules makes the server only allow calls from clients with a verified TLS certificate that matches one of the rules, and only to the methods that rule allows.
+// Clients without a certificate get UNAUTHENTICATED, others PERMISSION_DENIED. The grpc.Server must be configured to request client certificates. It only works with New or Register.
+//
+// For example, to allow any client of example.com to read, but only admin.*.example.com to make changes:
+//
+//	raftadmin.WithCertificateRules(
+//		raftadmin.CertificateRule{Identity: "admin.*.example.com"},
+//		raftadmin.CertificateRule{Identity: "*.example.com", ReadOnly: true},
+//	)
+func WithCertificateRules(rules ...CertificateRule) Option {
+	return func(a *admin) {
+		for _, r := range rules {
+			if _, err := path.Match(r.Identity, ""); err != nil {
+				panic(fmt.Errorf("WithCertificateRules: invalid pattern %q: %v", r.Identity, err))
+			}
+			for _, m := range r.Methods {
+				mustBeMethod("WithCertificateRules", m)
+			}
+		}
+		a.certificateRules = append(a.certificateRules, rules...)
+	}
+}
+
+// WithConfirmation makes RemoveServer, RestoreSnapshot and Shutdown fail with FAILED_PRECONDITION unless they carry a confirmation_token.
+// The error describes what the call would do, like which voter it removes and what that does to the quorum, and carries a token that is valid for ttl. Sending the same call with that token executes it, unless the effect has changed in the meantime.
+// raftadmin shows the description and asks for confirmation before sending the token.
+func WithConfirmation(ttl time.Duration) Option {
+	return func(a *admin) {
+		if ttl <= 0 {
+			panic(fmt.Errorf("WithConfirmation needs a positive duration, got %s", ttl))
+		}
+		a.confirmationTTL = ttl
+		a.confirmationSecret = make([]byte, 32)
+		if _, err := rand.Read(a.confirmationSecret); err != nil {
+			panic(fmt.Errorf("failed to generate secret for WithConfirmation: %v", err))
+		}
+	}
+}
+
+// WithDefaultTimeout sets a deadline on unary calls whose client didn't set one. It only works with New or Register.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(a *admin) {
+		a.defaultTimeout = d
+	}
+}
+
+// WithDisabledMethods makes the server reject calls to the given RPCs, like "Shutdown" and "ApplyLog", with PERMISSION_DENIED. It only works with New or Register.
+func WithDisabledMethods(methods ...string) Option {
+	return func(a *admin) {
+		if a.disabledMethods == nil {
+			a.disabledMethods = map[string]bool{}
+		}
+		for _, m := range methods {
+			mustBeMethod("WithDisabledMethods", m)
+			a.disabledMethods[m] = true
+		}
+	}
+}
+
+// WithExtension adds an application-specific method, served in the RaftAdminExtensions service on the same grpc.Server as RaftAdmin, so applications don't need a second admin service.
+// Calls pass through the same checks, logging and operations log as RaftAdmin calls, and options that take method names accept its name. raftadmin finds it through gRPC reflection, so the server must register that.
+// An extension with the same name must have the same types for every server in the process. It only works with New or Register.
+func WithExtension(e Extension) Option {
+	registerExtension(e)
+	return func(a *admin) {
+		if a.extensions == nil {
+			a.extensions = map[string]Extension{}
+		}
+		a.extensions[e.Name] = e
+	}
+}
+
+// WithFSMChecksum enables VerifyFSM, which returns the result of checksum along with the applied index, so the state of the nodes can be compared.
+// checksum should hash the FSM's state while holding the same lock that Apply takes, so the result matches the applied index.
+func WithFSMChecksum(checksum func() (uint64, error)) Option {
+	return func(a *admin) {
+		a.fsmChecksum = checksum
+	}
+}
+
+// WithFutureTTL makes the server forget operations that nobody awaited or forgot within ttl after they started, like those of clients that crashed. Operations that are still running or that someone is awaiting are kept.
+// Clients can't await an operation after it was reaped, so pick a ttl well above how long clients may take to come back for the result. With WithLeaderForwarding, followers also forget where forwarded operations live once they weren't awaited or forgotten for ttl.
+// It only works with New or Register.
+func WithFutureTTL(ttl time.Duration) Option {
+	return func(a *admin) {
+		if ttl <= 0 {
+			panic(fmt.Errorf("WithFutureTTL needs a positive duration, got %s", ttl))
+		}
+		a.futureTTL = ttl
+	}
+}
+
+// WithGRPCServerOptions adds options to the gRPC server that ListenAndServe creates, like limits on message sizes or interceptors. It only works with ListenAndServe.
+func WithGRPCServerOptions(opts ...grpc.ServerOption) Option {
+	return func(a *admin) {
+		a.grpcServerOptions = append(a.grpcServerOptions, opts...)
+	}
+}
+
+// WithHook makes the server call hook before handling every call, with the method name (like "RemoveServer") and the request. req is nil for streaming RPCs. If hook returns an error, the call is rejected with it.
+// Hooks run in the order they were given. It only works with New or Register.
+func WithHook(hook func(ctx context.Context, method string, req proto.Message) error) Option {
+	return func(a *admin) {
+		a.hooks = append(a.hooks, hook)
+	}
+}
+
+// WithHostPortAddresses makes AddVoter, AddNonvoter, LeadershipTransferToServer, BootstrapCluster and StageRecovery reject server addresses that aren't of the form host:port with INVALID_ARGUMENT.
+// Use it with raft's TCP transport or raft-grpc-transport, to catch typos before they end up in the configuration. Other transports, like raft.InmemTransport, use other addresses.
+func WithHostPortAddresses() Option {
+	return func(a *admin) {
+		a.hostPortAddresses = true
+	}
+}
+
+// WithIdempotentAdds makes AddVoter and AddNonvoter succeed without changing the configuration if the server is already in it with the same address and suffrage, so membership automation can safely be rerun.
+// The operation then completes right away with the index of the current configuration, as nothing is appended to the log. That index is 0 without WithLogStore, see GetConfigurationResponse.index. Adding a server with another address or suffrage still changes the configuration.
+func WithIdempotentAdds() Option {
+	return func(a *admin) {
+		a.idempotentAdds = true
+	}
+}
+
+// WithLeaderForwarding makes followers forward calls that only work on the leader, like AddVoter, ApplyLog and Barrier, to the current leader instead of failing, so clients don't need to find it.
+// The forwarded call carries the metadata and deadline of the original call, and Await and Forget of forwarded operations are forwarded to the same node. Calls are forwarded at most once.
+// It requires WithPeerDialOptions, and only works with New or Register. ApplyStream isn't forwarded. Use WithFutureTTL to have followers forget where operations live when nobody awaits or forgets them through the follower.
+func WithLeaderForwarding() Option {
+	return func(a *admin) {
+		a.forwarded = &forwardedFutures{tokens: map[string]*forwardedFuture{}}
+	}
+}
+
+// WithLocalAddress sets the raft address of this node, for Raft implementations other than *raft.Raft, like wrappers and fakes. The server uses it to recognize this node in the configuration, and reports it in ClusterInfo and traces.
+// It isn't needed for a *raft.Raft, whose address the server finds itself.
+func WithLocalAddress(addr raft.ServerAddress) Option {
+	return func(a *admin) {
+		a.localAddr = addr
+	}
+}
+
+// WithLogRepair enables DeleteLogRange, which deletes entries from the LogStore given with WithLogStore.
+// Only use this to repair a corrupted log in an emergency; deleting the wrong entries loses committed data.
+func WithLogRepair() Option {
+	return func(a *admin) {
+		a.logRepairSecret = make([]byte, 32)
+		if _, err := rand.Read(a.logRepairSecret); err != nil {
+			panic(fmt.Errorf("failed to generate secret for WithLogRepair: %v", err))
+		}
+	}
+}
+
+// WithLogStore gives the server read access to the LogStore passed to raft.NewRaft. It is required for GetLogs, and to report the index of the configuration.
+func WithLogStore(s raft.LogStore) Option {
+	return func(a *admin) {
+		a.logs = s
+	}
+}
+
+// WithLogger makes the server log every call it handles: rejected and failed calls at warning level and others at debug level. It only works with New or Register.
+// It also logs the lifecycle of the operations that return a future: when they start, complete and are forgotten.
+func WithLogger(l hclog.Logger) Option {
+	return func(a *admin) {
+		a.logger = l
+	}
+}
+
+// WithMaxApplySize makes ApplyLog, ApplyBatch and ApplyStream reject entries whose data and extensions together are larger than n bytes.
+func WithMaxApplySize(n int) Option {
+	return func(a *admin) {
+		a.maxApplySize = n
+	}
+}
+
+// WithMaxFutures makes the server reject calls that would start an operation with RESOURCE_EXHAUSTED while it holds n operations that haven't been forgotten yet, so a misbehaving client can't make it grow without bound.
+// Operations are held until they are forgotten with Forget or AwaitAndForget, or reaped by WithFutureTTL. The entries of ApplyBatch and ApplyStream count too, until their result is sent: a batch that doesn't fit is rejected as a whole, and entries of a stream that don't fit get an error result.
+func WithMaxFutures(n int) Option {
+	return func(a *admin) {
+		if n <= 0 {
+			panic(fmt.Errorf("WithMaxFutures needs a positive number, got %d", n))
+		}
+		a.maxFutures = n
+	}
+}
+
+// WithMetadata sets the initial tags of this node, like its zone, rack or build version. They can be changed at runtime with SetMetadata.
+func WithMetadata(md map[string]string) Option {
+	return func(a *admin) {
+		a.metadata = map[string]string{}
+		for k, v := range md {
+			a.metadata[k] = v
+		}
+	}
+}
+
+// WithMethodTimeout sets a deadline of d on calls to the given RPCs whose client didn't set one, instead of the one from WithDefaultTimeout. Like the deadline of the call, it bounds how long raft may take to start the operation.
+// Operations started by these RPCs fail in Await if they didn't finish within d, like a LeadershipTransfer to a node that never catches up, so stuck operations don't keep Await calls waiting forever. raft can't cancel them, so they might still happen.
+// Use it like WithMethodTimeout(5*time.Second, "Barrier") and WithMethodTimeout(30*time.Second, "LeadershipTransfer", "LeadershipTransferToServer"). It only works with New or Register.
+func WithMethodTimeout(d time.Duration, methods ...string) Option {
+	return func(a *admin) {
+		if d <= 0 {
+			panic(fmt.Errorf("WithMethodTimeout needs a positive duration, got %s", d))
+		}
+		if a.methodTimeouts == nil {
+			a.methodTimeouts = map[string]time.Duration{}
+		}
+		for _, m := range methods {
+			mustBeMethod("WithMethodTimeout", m)
+			a.methodTimeouts[m] = d
+		}
+	}
+}
+
+// WithOperationsLog makes the server remember the last size RPCs it handled, with their caller and result, for ListOperations. It only works with New or Register.
+func WithOperationsLog(size int) Option {
+	return func(a *admin) {
+		if size <= 0 {
+			panic(fmt.Errorf("WithOperationsLog needs a positive size, got %d", size))
+		}
+		a.operations = newOperationsLog(size)
+	}
+}
+
+// WithPeerDialOptions allows the server to connect to the RaftAdmin service of the other nodes, with the given dial options. It is required for ReplicationStatus.
+// The other nodes must serve RaftAdmin on their raft address, like they do when using https://github.com/Jille/raft-grpc-transport.
+func WithPeerDialOptions(opts ...grpc.DialOption) Option {
+	return func(a *admin) {
+		a.peerDialOptions = append([]grpc.DialOption{}, opts...)
+	}
+}
+
+// WithPolicyFile makes the server only allow calls that the policy in the JSON file at path allows. Callers are identified by their bearer token, if its hash is listed in the policy, and by their verified TLS client certificate.
+// Callers without either get UNAUTHENTICATED, others PERMISSION_DENIED. The file is loaded on the first call and reloaded when it changes; if it becomes invalid, the last valid policy stays in effect. It only works with New or Register.
+//
+// For example, to let admin.*.example.com do anything, the deploy job manage membership and anyone else of example.com read:
+//
+//	{
+//		"tokens": {"deploy": "<hex encoded SHA-256 of the token>"},
+//		"rules": [
+//			{"identities": ["admin.*.example.com"], "allow": ["read-only", "membership", "destructive"]},
+//			{"identities": ["token:deploy"], "allow": ["read-only", "membership", "Snapshot"]},
+//			{"identities": ["*.example.com"], "allow": ["read-only"]}
+//		]
+//	}
+func WithPolicyFile(path string) Option {
+	return func(a *admin) {
+		a.policy = &policyFile{path: path}
+	}
+}
+
+// WithQuorumChecks makes the leader check what AddVoter, DemoteVoter and RemoveServer do to quorum before starting them. Calls that would leave fewer reachable voters than needed for quorum fail with FAILED_PRECONDITION, unless their force field is set.
+// Changes that leave an even number of voters go through, with a warning in the returned FutureResponse: they make quorum larger without surviving more failures. Which voters are reachable is known from the leader's heartbeats, so followers don't check anything.
+func WithQuorumChecks() Option {
+	return func(a *admin) {
+		a.quorumChecks = true
+	}
+}
+
+// WithRateLimit makes the server allow at most one call per interval to the given RPCs together, and reject the others with RESOURCE_EXHAUSTED. Calls rejected for another reason, including by WithHook, don't count.
+// Use it to protect the cluster from runaway automation, e.g. WithRateLimit(10*time.Second, "AddVoter", "AddNonvoter", "DemoteVoter", "RemoveServer") and WithRateLimit(time.Minute, "Snapshot"). It only works with New or Register.
+func WithRateLimit(interval time.Duration, methods ...string) Option {
+	return func(a *admin) {
+		if interval <= 0 {
+			panic(fmt.Errorf("WithRateLimit needs a positive interval, got %s", interval))
+		}
+		l := &rateLimit{interval: interval, methods: map[string]bool{}}
+		for _, m := range methods {
+			mustBeMethod("WithRateLimit", m)
+			l.methods[m] = true
+		}
+		a.rateLimits = append(a.rateLimits, l)
+	}
+}
+
+// WithReadOnly makes the server reject every RPC that changes state, or that returns the data in the log or snapshots, with PERMISSION_DENIED.
+// Use it to expose the service to dashboards or on less-trusted networks, on a separate grpc.Server. It only works with New or Register.
+func WithReadOnly() Option {
+	return func(a *admin) {
+		a.readOnly = true
+	}
+}
+
+// WithRecoveryFile allows StageRecovery to write a replacement configuration to path, in the peers.json format.
+// Call RecoverFromFile with the same path before raft.NewRaft to apply it.
+func WithRecoveryFile(path string) Option {
+	return func(a *admin) {
+		a.recoveryFile = path
+	}
+}
+
+// WithRejectEmptyEntries makes ApplyLog, ApplyBatch and ApplyStream reject entries without data with INVALID_ARGUMENT, for FSMs that can't make sense of them.
+func WithRejectEmptyEntries() Option {
+	return func(a *admin) {
+		a.rejectEmptyEntries = true
+	}
+}
+
+// WithRequireLeader makes followers reject calls that only work on the leader, like AddVoter, ApplyLog and ApplyStream, before handling them.
+// They fail with FAILED_PRECONDITION and the current leader in the same ErrorInfo detail as other not-leader errors, rather than with whatever raft returns after partially processing the call.
+// With WithLeaderForwarding, such calls are forwarded to the leader instead, if there is one. It only works with New or Register.
+func WithRequireLeader() Option {
+	return func(a *admin) {
+		a.requireLeader = true
+	}
+}
+
+// WithServeContext makes ListenAndServe stop gracefully once ctx is done. It only works with ListenAndServe.
+func WithServeContext(ctx context.Context) Option {
+	return func(a *admin) {
+		a.serveContext = ctx
+	}
+}
+
+// WithSnapshotStore gives the server access to the SnapshotStore passed to raft.NewRaft. It is required for DownloadSnapshot and ListSnapshots.
+func WithSnapshotStore(s raft.SnapshotStore) Option {
+	return func(a *admin) {
+		a.snapshots = s
+	}
+}
+
+// WithStableStore gives the server read access to the StableStore passed to raft.NewRaft. It is required for GetStableStore.
+// This exposes raft internals, so only pass it if you want to allow debugging elections remotely.
+func WithStableStore(s raft.StableStore) Option {
+	return func(a *admin) {
+		a.stable = s
+	}
+}
+
+// WithStatsHistory makes the server sample the stats of this node every interval and keep the last size samples, so StatsHistory can show what happened before an incident.
+// It only works with New or Register.
+func WithStatsHistory(interval time.Duration, size int) Option {
+	return func(a *admin) {
+		if interval <= 0 || size <= 0 {
+			panic(fmt.Errorf("WithStatsHistory needs a positive interval and size, got %s and %d", interval, size))
+		}
+		a.history = newStatsHistory(interval, size)
+	}
+}
+
+// WithTLSConfig makes ListenAndServe serve TLS with the given config. Set its ClientAuth to verify client certificates for WithCertificateRules. It only works with ListenAndServe.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(a *admin) {
+		a.tlsConfig = cfg
+	}
+}
+
+// WithTracerProvider makes the server start an OpenTelemetry span for every call, with the method and the ID and address of this node. It continues the trace of the client, using the global propagator from otel.GetTextMapPropagator if the grpc.Server doesn't already trace calls.
+// Await and Forget of an operation also get a span in the trace of the call that started it, with the outcome of the operation. It only works with New or Register.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(a *admin) {
+		a.tracer = tp.Tracer(tracerName)
+	}
+}
@@ -0,0 +1,205 @@
+package raftadmin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// policyCheckInterval is how often a policy file is checked for changes. The check happens on the next call after the interval, so idle servers don't touch the file.
+const policyCheckInterval = time.Second
+
+// membershipMethods are the RPCs of the "membership" group in a Policy: those that change who is in the cluster or who leads it, and those needed to wait for them.
+var membershipMethods = map[string]bool{
+	"AddNonvoter":                true,
+	"AddVoter":                   true,
+	"Await":                      true,
+	"AwaitAndForget":             true,
+	"DemoteVoter":                true,
+	"Forget":                     true,
+	"LeadershipTransfer":         true,
+	"LeadershipTransferToServer": true,
+	"RemoveServer":               true,
+	"StepDown":                   true,
+}
+
+// Policy maps the identities of callers to the RPCs they may call. See WithPolicyFile for the file format.
+type Policy struct {
+	// Tokens maps token subjects to the hex encoded SHA-256 hash of their bearer token, so the policy file doesn't contain the tokens themselves.
+	Tokens map[string]string `json:"tokens"`
+	// Rules are checked in order until one matches the caller and allows the method.
+	Rules []PolicyRule `json:"rules"`
+}
+
+// PolicyRule allows callers with a matching identity to call some methods.
+type PolicyRule struct {
+	// Identities are matched with the syntax of path.Match against "token:" followed by the subject of the caller's bearer token, and against the DNS names, URIs and common name of its verified TLS client certificate.
+	Identities []string `json:"identities"`
+	// Allow lists the groups "read-only" (what WithReadOnly allows), "membership" (adding, removing and demoting servers and transferring leadership) and "destructive" (everything else), and individual methods like "Snapshot".
+	Allow []string `json:"allow"`
+}
+
+// ParsePolicy parses and validates a policy in the format described at WithPolicyFile.
+func ParsePolicy(data []byte) (*Policy, error) {
+	var p Policy
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return nil, err
+	}
+	for subject, hash := range p.Tokens {
+		if b, err := hex.DecodeString(hash); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("token %q: not a hex encoded SHA-256 hash", subject)
+		}
+	}
+	for i, r := range p.Rules {
+		for _, id := range r.Identities {
+			if _, err := path.Match(id, ""); err != nil {
+				return nil, fmt.Errorf("rule %d: invalid pattern %q: %v", i, id, err)
+			}
+		}
+		for _, m := range r.Allow {
+			switch m {
+			case "read-only", "membership", "destructive":
+			default:
+				if !isMethod(m) {
+					return nil, fmt.Errorf("rule %d: %q is neither a group nor a method of RaftAdmin or an extension", i, m)
+				}
+			}
+		}
+	}
+	return &p, nil
+}
+
+// identities returns the identities of the caller that rules are matched against.
+func (p *Policy) identities(ctx context.Context) []string {
+	var ids []string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vals := md.Get("authorization"); len(vals) > 0 && strings.HasPrefix(vals[0], "Bearer ") {
+		sum := sha256.Sum256([]byte(strings.TrimPrefix(vals[0], "Bearer ")))
+		for subject, hash := range p.Tokens {
+			want, _ := hex.DecodeString(hash)
+			if subtle.ConstantTimeCompare(sum[:], want) == 1 {
+				ids = append(ids, "token:"+subject)
+			}
+		}
+	}
+	if cert, ok := ClientCertificate(ctx); ok {
+		ids = append(ids, certificateIdentities(cert)...)
+	}
+	return ids
+}
+
+// allows returns whether the rule allows method for a caller with the given identities.
+func (r PolicyRule) allows(identities []string, method string) bool {
+	if !matchesAny(r.Identities, identities) {
+		return false
+	}
+	for _, m := range r.Allow {
+		switch m {
+		case "read-only":
+			if isReadOnlyMethod(method) {
+				return true
+			}
+		case "membership":
+			if membershipMethods[method] {
+				return true
+			}
+		case "destructive":
+			if !isReadOnlyMethod(method) && !membershipMethods[method] {
+				return true
+			}
+		case method:
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny returns whether any of the identities matches any of the patterns.
+func matchesAny(patterns, identities []string) bool {
+	for _, p := range patterns {
+		for _, id := range identities {
+			if ok, _ := path.Match(p, id); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// policyFile is the policy loaded by WithPolicyFile. It is reloaded when the file changes.
+type policyFile struct {
+	path string
+
+	mtx       sync.Mutex
+	policy    *Policy
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+// get returns the current policy, reloading the file if it changed. If the file can't be loaded, it keeps the last good policy. It returns nil if there never was one.
+func (f *policyFile) get(a *admin) *Policy {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if time.Since(f.lastCheck) < policyCheckInterval {
+		return f.policy
+	}
+	f.lastCheck = time.Now()
+	fi, err := os.Stat(f.path)
+	if err == nil && fi.ModTime().Equal(f.modTime) {
+		return f.policy
+	}
+	var p *Policy
+	if err == nil {
+		// Don't retry a broken file until it changes again.
+		f.modTime = fi.ModTime()
+		var data []byte
+		data, err = os.ReadFile(f.path)
+		if err == nil {
+			p, err = ParsePolicy(data)
+		}
+	}
+	if err != nil {
+		if a.logger != nil {
+			a.logger.Error("failed to load raftadmin policy, keeping the previous one", "path", f.path, "error", err)
+		}
+		return f.policy
+	}
+	if a.logger != nil && f.policy != nil {
+		a.logger.Info("reloaded raftadmin policy", "path", f.path)
+	}
+	f.policy = p
+	return p
+}
+
+// checkPolicy returns an error unless the policy set by WithPolicyFile allows the caller to call method.
+func (a *admin) checkPolicy(ctx context.Context, method string) error {
+	p := a.policy.get(a)
+	if p == nil {
+		return status.Errorf(codes.Unavailable, "the policy in %s couldn't be loaded", a.policy.path)
+	}
+	ids := p.identities(ctx)
+	if len(ids) == 0 {
+		return status.Error(codes.Unauthenticated, "a bearer token from the policy or a verified TLS client certificate is required")
+	}
+	for _, r := range p.Rules {
+		if r.allows(ids, method) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed for %v by the policy", method, ids)
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
+// 	protoc-gen-go v1.31.0
 // 	protoc        v3.5.1
 // source: raftadmin.proto
 
@@ -8,7 +8,6 @@ package proto
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -25,10 +24,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type GetConfigurationResponse_Server_Suffrage int32
 
 const (
@@ -75,7 +70,124 @@ func (x GetConfigurationResponse_Server_Suffrage) Number() protoreflect.EnumNumb
 
 // Deprecated: Use GetConfigurationResponse_Server_Suffrage.Descriptor instead.
 func (GetConfigurationResponse_Server_Suffrage) EnumDescriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{11, 0, 0}
+	return file_raftadmin_proto_rawDescGZIP(), []int{25, 0, 0}
+}
+
+// The values match raft.LogType.
+type GetLogsResponse_Log_Type int32
+
+const (
+	GetLogsResponse_Log_COMMAND                GetLogsResponse_Log_Type = 0
+	GetLogsResponse_Log_NOOP                   GetLogsResponse_Log_Type = 1
+	GetLogsResponse_Log_ADD_PEER_DEPRECATED    GetLogsResponse_Log_Type = 2
+	GetLogsResponse_Log_REMOVE_PEER_DEPRECATED GetLogsResponse_Log_Type = 3
+	GetLogsResponse_Log_BARRIER                GetLogsResponse_Log_Type = 4
+	GetLogsResponse_Log_CONFIGURATION          GetLogsResponse_Log_Type = 5
+)
+
+// Enum value maps for GetLogsResponse_Log_Type.
+var (
+	GetLogsResponse_Log_Type_name = map[int32]string{
+		0: "COMMAND",
+		1: "NOOP",
+		2: "ADD_PEER_DEPRECATED",
+		3: "REMOVE_PEER_DEPRECATED",
+		4: "BARRIER",
+		5: "CONFIGURATION",
+	}
+	GetLogsResponse_Log_Type_value = map[string]int32{
+		"COMMAND":                0,
+		"NOOP":                   1,
+		"ADD_PEER_DEPRECATED":    2,
+		"REMOVE_PEER_DEPRECATED": 3,
+		"BARRIER":                4,
+		"CONFIGURATION":          5,
+	}
+)
+
+func (x GetLogsResponse_Log_Type) Enum() *GetLogsResponse_Log_Type {
+	p := new(GetLogsResponse_Log_Type)
+	*p = x
+	return p
+}
+
+func (x GetLogsResponse_Log_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetLogsResponse_Log_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_raftadmin_proto_enumTypes[1].Descriptor()
+}
+
+func (GetLogsResponse_Log_Type) Type() protoreflect.EnumType {
+	return &file_raftadmin_proto_enumTypes[1]
+}
+
+func (x GetLogsResponse_Log_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetLogsResponse_Log_Type.Descriptor instead.
+func (GetLogsResponse_Log_Type) EnumDescriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{29, 0, 0}
+}
+
+type ObserveRequest_Type int32
+
+const (
+	ObserveRequest_LEADER            ObserveRequest_Type = 0
+	ObserveRequest_PEER              ObserveRequest_Type = 1
+	ObserveRequest_FAILED_HEARTBEAT  ObserveRequest_Type = 2
+	ObserveRequest_RESUMED_HEARTBEAT ObserveRequest_Type = 3
+	ObserveRequest_REQUEST_VOTE      ObserveRequest_Type = 4
+	ObserveRequest_STATE             ObserveRequest_Type = 5
+)
+
+// Enum value maps for ObserveRequest_Type.
+var (
+	ObserveRequest_Type_name = map[int32]string{
+		0: "LEADER",
+		1: "PEER",
+		2: "FAILED_HEARTBEAT",
+		3: "RESUMED_HEARTBEAT",
+		4: "REQUEST_VOTE",
+		5: "STATE",
+	}
+	ObserveRequest_Type_value = map[string]int32{
+		"LEADER":            0,
+		"PEER":              1,
+		"FAILED_HEARTBEAT":  2,
+		"RESUMED_HEARTBEAT": 3,
+		"REQUEST_VOTE":      4,
+		"STATE":             5,
+	}
+)
+
+func (x ObserveRequest_Type) Enum() *ObserveRequest_Type {
+	p := new(ObserveRequest_Type)
+	*p = x
+	return p
+}
+
+func (x ObserveRequest_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ObserveRequest_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_raftadmin_proto_enumTypes[2].Descriptor()
+}
+
+func (ObserveRequest_Type) Type() protoreflect.EnumType {
+	return &file_raftadmin_proto_enumTypes[2]
+}
+
+func (x ObserveRequest_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ObserveRequest_Type.Descriptor instead.
+func (ObserveRequest_Type) EnumDescriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{57, 0}
 }
 
 type StateResponse_State int32
@@ -114,11 +226,11 @@ func (x StateResponse_State) String() string {
 }
 
 func (StateResponse_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_raftadmin_proto_enumTypes[1].Descriptor()
+	return file_raftadmin_proto_enumTypes[3].Descriptor()
 }
 
 func (StateResponse_State) Type() protoreflect.EnumType {
-	return &file_raftadmin_proto_enumTypes[1]
+	return &file_raftadmin_proto_enumTypes[3]
 }
 
 func (x StateResponse_State) Number() protoreflect.EnumNumber {
@@ -127,7 +239,7 @@ func (x StateResponse_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StateResponse_State.Descriptor instead.
 func (StateResponse_State) EnumDescriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{24, 0}
+	return file_raftadmin_proto_rawDescGZIP(), []int{83, 0}
 }
 
 type Future struct {
@@ -135,6 +247,7 @@ type Future struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// A random token for Await and Forget. If the call that started the operation sent a bearer token or a TLS client certificate, only callers with the same credentials can use it.
 	OperationToken string `protobuf:"bytes,1,opt,name=operation_token,json=operationToken,proto3" json:"operation_token,omitempty"`
 }
 
@@ -177,6 +290,72 @@ func (x *Future) GetOperationToken() string {
 	return ""
 }
 
+type FutureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The token of the operation that was started. Pass it in a Future to Await and Forget.
+	OperationToken string `protobuf:"bytes,1,opt,name=operation_token,json=operationToken,proto3" json:"operation_token,omitempty"`
+	// Only set if the call was made with the "raftadmin-await: true" metadata. The server then waited for the operation and forgot it, and this is its result.
+	Result *AwaitResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// Things the caller should know about the operation, like a membership change that leaves an even number of voters. Only servers using raftadmin.WithQuorumChecks set these.
+	Warnings []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *FutureResponse) Reset() {
+	*x = FutureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FutureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FutureResponse) ProtoMessage() {}
+
+func (x *FutureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FutureResponse.ProtoReflect.Descriptor instead.
+func (*FutureResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FutureResponse) GetOperationToken() string {
+	if x != nil {
+		return x.OperationToken
+	}
+	return ""
+}
+
+func (x *FutureResponse) GetResult() *AwaitResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *FutureResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type AwaitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -184,12 +363,14 @@ type AwaitResponse struct {
 
 	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Set if the operation failed because this node isn't the leader: the leader at the time of the Await, if any.
+	Leader *LeaderResponse `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
 }
 
 func (x *AwaitResponse) Reset() {
 	*x = AwaitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[1]
+		mi := &file_raftadmin_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -202,7 +383,7 @@ func (x *AwaitResponse) String() string {
 func (*AwaitResponse) ProtoMessage() {}
 
 func (x *AwaitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[1]
+	mi := &file_raftadmin_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +396,7 @@ func (x *AwaitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AwaitResponse.ProtoReflect.Descriptor instead.
 func (*AwaitResponse) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{1}
+	return file_raftadmin_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AwaitResponse) GetError() string {
@@ -232,6 +413,13 @@ func (x *AwaitResponse) GetIndex() uint64 {
 	return 0
 }
 
+func (x *AwaitResponse) GetLeader() *LeaderResponse {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
 type ForgetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -241,7 +429,7 @@ type ForgetResponse struct {
 func (x *ForgetResponse) Reset() {
 	*x = ForgetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[2]
+		mi := &file_raftadmin_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -254,7 +442,7 @@ func (x *ForgetResponse) String() string {
 func (*ForgetResponse) ProtoMessage() {}
 
 func (x *ForgetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[2]
+	mi := &file_raftadmin_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +455,7 @@ func (x *ForgetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgetResponse.ProtoReflect.Descriptor instead.
 func (*ForgetResponse) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{2}
+	return file_raftadmin_proto_rawDescGZIP(), []int{3}
 }
 
 type AddVoterRequest struct {
@@ -278,12 +466,16 @@ type AddVoterRequest struct {
 	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	PreviousIndex uint64 `protobuf:"varint,3,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index,omitempty"`
+	// How long raft may take to start the operation. 0 means the deadline of the RPC, if any.
+	TimeoutMs uint64 `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Add the voter even if servers using raftadmin.WithQuorumChecks would reject it.
+	Force bool `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
 }
 
 func (x *AddVoterRequest) Reset() {
 	*x = AddVoterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[3]
+		mi := &file_raftadmin_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -296,7 +488,7 @@ func (x *AddVoterRequest) String() string {
 func (*AddVoterRequest) ProtoMessage() {}
 
 func (x *AddVoterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[3]
+	mi := &file_raftadmin_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +501,7 @@ func (x *AddVoterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVoterRequest.ProtoReflect.Descriptor instead.
 func (*AddVoterRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{3}
+	return file_raftadmin_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AddVoterRequest) GetId() string {
@@ -333,6 +525,20 @@ func (x *AddVoterRequest) GetPreviousIndex() uint64 {
 	return 0
 }
 
+func (x *AddVoterRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *AddVoterRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type AddNonvoterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -341,12 +547,14 @@ type AddNonvoterRequest struct {
 	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	PreviousIndex uint64 `protobuf:"varint,3,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index,omitempty"`
+	// How long raft may take to start the operation. 0 means the deadline of the RPC, if any.
+	TimeoutMs uint64 `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
 func (x *AddNonvoterRequest) Reset() {
 	*x = AddNonvoterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[4]
+		mi := &file_raftadmin_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -359,7 +567,7 @@ func (x *AddNonvoterRequest) String() string {
 func (*AddNonvoterRequest) ProtoMessage() {}
 
 func (x *AddNonvoterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[4]
+	mi := &file_raftadmin_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +580,7 @@ func (x *AddNonvoterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddNonvoterRequest.ProtoReflect.Descriptor instead.
 func (*AddNonvoterRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{4}
+	return file_raftadmin_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AddNonvoterRequest) GetId() string {
@@ -396,6 +604,108 @@ func (x *AddNonvoterRequest) GetPreviousIndex() uint64 {
 	return 0
 }
 
+func (x *AddNonvoterRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ApplyBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ApplyLogRequest `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ApplyBatchRequest) Reset() {
+	*x = ApplyBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBatchRequest) ProtoMessage() {}
+
+func (x *ApplyBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBatchRequest.ProtoReflect.Descriptor instead.
+func (*ApplyBatchRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApplyBatchRequest) GetEntries() []*ApplyLogRequest {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ApplyBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One result per entry, in the same order as the request.
+	Results []*AwaitResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ApplyBatchResponse) Reset() {
+	*x = ApplyBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBatchResponse) ProtoMessage() {}
+
+func (x *ApplyBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBatchResponse.ProtoReflect.Descriptor instead.
+func (*ApplyBatchResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApplyBatchResponse) GetResults() []*AwaitResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type ApplyLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -403,12 +713,14 @@ type ApplyLogRequest struct {
 
 	Data       []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Extensions []byte `protobuf:"bytes,2,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	// How long raft may take to start the operation. 0 means the deadline of the RPC, if any.
+	TimeoutMs uint64 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
 func (x *ApplyLogRequest) Reset() {
 	*x = ApplyLogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[5]
+		mi := &file_raftadmin_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -421,7 +733,7 @@ func (x *ApplyLogRequest) String() string {
 func (*ApplyLogRequest) ProtoMessage() {}
 
 func (x *ApplyLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[5]
+	mi := &file_raftadmin_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +746,7 @@ func (x *ApplyLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyLogRequest.ProtoReflect.Descriptor instead.
 func (*ApplyLogRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{5}
+	return file_raftadmin_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ApplyLogRequest) GetData() []byte {
@@ -451,6 +763,13 @@ func (x *ApplyLogRequest) GetExtensions() []byte {
 	return nil
 }
 
+func (x *ApplyLogRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
 type AppliedIndexRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -460,7 +779,7 @@ type AppliedIndexRequest struct {
 func (x *AppliedIndexRequest) Reset() {
 	*x = AppliedIndexRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[6]
+		mi := &file_raftadmin_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -473,7 +792,7 @@ func (x *AppliedIndexRequest) String() string {
 func (*AppliedIndexRequest) ProtoMessage() {}
 
 func (x *AppliedIndexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[6]
+	mi := &file_raftadmin_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +805,7 @@ func (x *AppliedIndexRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppliedIndexRequest.ProtoReflect.Descriptor instead.
 func (*AppliedIndexRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{6}
+	return file_raftadmin_proto_rawDescGZIP(), []int{9}
 }
 
 type AppliedIndexResponse struct {
@@ -500,7 +819,7 @@ type AppliedIndexResponse struct {
 func (x *AppliedIndexResponse) Reset() {
 	*x = AppliedIndexResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[7]
+		mi := &file_raftadmin_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -513,7 +832,7 @@ func (x *AppliedIndexResponse) String() string {
 func (*AppliedIndexResponse) ProtoMessage() {}
 
 func (x *AppliedIndexResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[7]
+	mi := &file_raftadmin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,7 +845,7 @@ func (x *AppliedIndexResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppliedIndexResponse.ProtoReflect.Descriptor instead.
 func (*AppliedIndexResponse) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{7}
+	return file_raftadmin_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AppliedIndexResponse) GetIndex() uint64 {
@@ -540,12 +859,15 @@ type BarrierRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// How long raft may take to start the operation. 0 means the deadline of the RPC, if any.
+	TimeoutMs uint64 `protobuf:"varint,1,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
 func (x *BarrierRequest) Reset() {
 	*x = BarrierRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[8]
+		mi := &file_raftadmin_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -558,7 +880,7 @@ func (x *BarrierRequest) String() string {
 func (*BarrierRequest) ProtoMessage() {}
 
 func (x *BarrierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[8]
+	mi := &file_raftadmin_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,35 +893,41 @@ func (x *BarrierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BarrierRequest.ProtoReflect.Descriptor instead.
 func (*BarrierRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{8}
+	return file_raftadmin_proto_rawDescGZIP(), []int{11}
 }
 
-type DemoteVoterRequest struct {
+func (x *BarrierRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type BootstrapClusterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PreviousIndex uint64 `protobuf:"varint,2,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index,omitempty"`
+	Servers []*GetConfigurationResponse_Server `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
 }
 
-func (x *DemoteVoterRequest) Reset() {
-	*x = DemoteVoterRequest{}
+func (x *BootstrapClusterRequest) Reset() {
+	*x = BootstrapClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[9]
+		mi := &file_raftadmin_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DemoteVoterRequest) String() string {
+func (x *BootstrapClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DemoteVoterRequest) ProtoMessage() {}
+func (*BootstrapClusterRequest) ProtoMessage() {}
 
-func (x *DemoteVoterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[9]
+func (x *BootstrapClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,48 +938,41 @@ func (x *DemoteVoterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DemoteVoterRequest.ProtoReflect.Descriptor instead.
-func (*DemoteVoterRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *DemoteVoterRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use BootstrapClusterRequest.ProtoReflect.Descriptor instead.
+func (*BootstrapClusterRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DemoteVoterRequest) GetPreviousIndex() uint64 {
+func (x *BootstrapClusterRequest) GetServers() []*GetConfigurationResponse_Server {
 	if x != nil {
-		return x.PreviousIndex
+		return x.Servers
 	}
-	return 0
+	return nil
 }
 
-type GetConfigurationRequest struct {
+type ClusterInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetConfigurationRequest) Reset() {
-	*x = GetConfigurationRequest{}
+func (x *ClusterInfoRequest) Reset() {
+	*x = ClusterInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[10]
+		mi := &file_raftadmin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetConfigurationRequest) String() string {
+func (x *ClusterInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigurationRequest) ProtoMessage() {}
+func (*ClusterInfoRequest) ProtoMessage() {}
 
-func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[10]
+func (x *ClusterInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,36 +983,44 @@ func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ClusterInfoRequest.ProtoReflect.Descriptor instead.
+func (*ClusterInfoRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{13}
 }
 
-type GetConfigurationResponse struct {
+type ClusterInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Servers []*GetConfigurationResponse_Server `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The ID of this node, if it can be found in the configuration by its address.
+	Id            string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string                    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	State         StateResponse_State       `protobuf:"varint,3,opt,name=state,proto3,enum=StateResponse_State" json:"state,omitempty"`
+	Term          uint64                    `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	Leader        *LeaderResponse           `protobuf:"bytes,5,opt,name=leader,proto3" json:"leader,omitempty"`
+	Configuration *GetConfigurationResponse `protobuf:"bytes,6,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Indexes       *GetIndexesResponse       `protobuf:"bytes,7,opt,name=indexes,proto3" json:"indexes,omitempty"`
+	LastSnapshot  *LastSnapshotResponse     `protobuf:"bytes,8,opt,name=last_snapshot,json=lastSnapshot,proto3" json:"last_snapshot,omitempty"`
 }
 
-func (x *GetConfigurationResponse) Reset() {
-	*x = GetConfigurationResponse{}
+func (x *ClusterInfoResponse) Reset() {
+	*x = ClusterInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[11]
+		mi := &file_raftadmin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetConfigurationResponse) String() string {
+func (x *ClusterInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigurationResponse) ProtoMessage() {}
+func (*ClusterInfoResponse) ProtoMessage() {}
 
-func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[11]
+func (x *ClusterInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,81 +1031,95 @@ func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ClusterInfoResponse.ProtoReflect.Descriptor instead.
+func (*ClusterInfoResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetConfigurationResponse) GetServers() []*GetConfigurationResponse_Server {
+func (x *ClusterInfoResponse) GetId() string {
 	if x != nil {
-		return x.Servers
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type LastContactRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ClusterInfoResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x *LastContactRequest) Reset() {
-	*x = LastContactRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ClusterInfoResponse) GetState() StateResponse_State {
+	if x != nil {
+		return x.State
 	}
+	return StateResponse_FOLLOWER
 }
 
-func (x *LastContactRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ClusterInfoResponse) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
 }
 
-func (*LastContactRequest) ProtoMessage() {}
+func (x *ClusterInfoResponse) GetLeader() *LeaderResponse {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
 
-func (x *LastContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ClusterInfoResponse) GetConfiguration() *GetConfigurationResponse {
+	if x != nil {
+		return x.Configuration
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LastContactRequest.ProtoReflect.Descriptor instead.
-func (*LastContactRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{12}
+func (x *ClusterInfoResponse) GetIndexes() *GetIndexesResponse {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
 }
 
-type LastContactResponse struct {
+func (x *ClusterInfoResponse) GetLastSnapshot() *LastSnapshotResponse {
+	if x != nil {
+		return x.LastSnapshot
+	}
+	return nil
+}
+
+type DeleteLogRangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnixNano int64 `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+	// Inclusive.
+	MinIndex          uint64 `protobuf:"varint,1,opt,name=min_index,json=minIndex,proto3" json:"min_index,omitempty"`
+	MaxIndex          uint64 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+	ConfirmationToken string `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
 }
 
-func (x *LastContactResponse) Reset() {
-	*x = LastContactResponse{}
+func (x *DeleteLogRangeRequest) Reset() {
+	*x = DeleteLogRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[13]
+		mi := &file_raftadmin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastContactResponse) String() string {
+func (x *DeleteLogRangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastContactResponse) ProtoMessage() {}
+func (*DeleteLogRangeRequest) ProtoMessage() {}
 
-func (x *LastContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[13]
+func (x *DeleteLogRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,41 +1130,62 @@ func (x *LastContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastContactResponse.ProtoReflect.Descriptor instead.
-func (*LastContactResponse) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteLogRangeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLogRangeRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LastContactResponse) GetUnixNano() int64 {
+func (x *DeleteLogRangeRequest) GetMinIndex() uint64 {
 	if x != nil {
-		return x.UnixNano
+		return x.MinIndex
 	}
 	return 0
 }
 
-type LastIndexRequest struct {
+func (x *DeleteLogRangeRequest) GetMaxIndex() uint64 {
+	if x != nil {
+		return x.MaxIndex
+	}
+	return 0
+}
+
+func (x *DeleteLogRangeRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+type DeleteLogRangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Only set if no token was given.
+	ConfirmationToken string `protobuf:"bytes,1,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	Deleted           bool   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// The range of the log after the call.
+	FirstIndex uint64 `protobuf:"varint,3,opt,name=first_index,json=firstIndex,proto3" json:"first_index,omitempty"`
+	LastIndex  uint64 `protobuf:"varint,4,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
 }
 
-func (x *LastIndexRequest) Reset() {
-	*x = LastIndexRequest{}
+func (x *DeleteLogRangeResponse) Reset() {
+	*x = DeleteLogRangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[14]
+		mi := &file_raftadmin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastIndexRequest) String() string {
+func (x *DeleteLogRangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastIndexRequest) ProtoMessage() {}
+func (*DeleteLogRangeResponse) ProtoMessage() {}
 
-func (x *LastIndexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[14]
+func (x *DeleteLogRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,36 +1196,69 @@ func (x *LastIndexRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastIndexRequest.ProtoReflect.Descriptor instead.
-func (*LastIndexRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeleteLogRangeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLogRangeResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{16}
 }
 
-type LastIndexResponse struct {
+func (x *DeleteLogRangeResponse) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+func (x *DeleteLogRangeResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DeleteLogRangeResponse) GetFirstIndex() uint64 {
+	if x != nil {
+		return x.FirstIndex
+	}
+	return 0
+}
+
+func (x *DeleteLogRangeResponse) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+type DemoteVoterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PreviousIndex uint64 `protobuf:"varint,2,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index,omitempty"`
+	// How long raft may take to start the operation. 0 means the deadline of the RPC, if any.
+	TimeoutMs uint64 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Demote the voter even if servers using raftadmin.WithQuorumChecks would reject it.
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *LastIndexResponse) Reset() {
-	*x = LastIndexResponse{}
+func (x *DemoteVoterRequest) Reset() {
+	*x = DemoteVoterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[15]
+		mi := &file_raftadmin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastIndexResponse) String() string {
+func (x *DemoteVoterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastIndexResponse) ProtoMessage() {}
+func (*DemoteVoterRequest) ProtoMessage() {}
 
-func (x *LastIndexResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[15]
+func (x *DemoteVoterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,41 +1269,65 @@ func (x *LastIndexResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastIndexResponse.ProtoReflect.Descriptor instead.
-func (*LastIndexResponse) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DemoteVoterRequest.ProtoReflect.Descriptor instead.
+func (*DemoteVoterRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *LastIndexResponse) GetIndex() uint64 {
+func (x *DemoteVoterRequest) GetId() string {
 	if x != nil {
-		return x.Index
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DemoteVoterRequest) GetPreviousIndex() uint64 {
+	if x != nil {
+		return x.PreviousIndex
 	}
 	return 0
 }
 
-type LeaderRequest struct {
+func (x *DemoteVoterRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *DemoteVoterRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DownloadSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Leave empty to download the latest snapshot.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *LeaderRequest) Reset() {
-	*x = LeaderRequest{}
+func (x *DownloadSnapshotRequest) Reset() {
+	*x = DownloadSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[16]
+		mi := &file_raftadmin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaderRequest) String() string {
+func (x *DownloadSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaderRequest) ProtoMessage() {}
+func (*DownloadSnapshotRequest) ProtoMessage() {}
 
-func (x *LeaderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[16]
+func (x *DownloadSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,36 +1338,49 @@ func (x *LeaderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaderRequest.ProtoReflect.Descriptor instead.
-func (*LeaderRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DownloadSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DownloadSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{18}
 }
 
-type LeaderResponse struct {
+func (x *DownloadSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DownloadSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The first message contains the metadata, followed by the data in chunks, followed by the SHA-256 of the data.
+	//
+	// Types that are assignable to Message:
+	//	*DownloadSnapshotResponse_Meta
+	//	*DownloadSnapshotResponse_Data
+	//	*DownloadSnapshotResponse_Sha256
+	Message isDownloadSnapshotResponse_Message `protobuf_oneof:"message"`
 }
 
-func (x *LeaderResponse) Reset() {
-	*x = LeaderResponse{}
+func (x *DownloadSnapshotResponse) Reset() {
+	*x = DownloadSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[17]
+		mi := &file_raftadmin_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaderResponse) String() string {
+func (x *DownloadSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaderResponse) ProtoMessage() {}
+func (*DownloadSnapshotResponse) ProtoMessage() {}
 
-func (x *LeaderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[17]
+func (x *DownloadSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,122 +1391,72 @@ func (x *LeaderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaderResponse.ProtoReflect.Descriptor instead.
-func (*LeaderResponse) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DownloadSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DownloadSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LeaderResponse) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (m *DownloadSnapshotResponse) GetMessage() isDownloadSnapshotResponse_Message {
+	if m != nil {
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-type LeadershipTransferRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *LeadershipTransferRequest) Reset() {
-	*x = LeadershipTransferRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DownloadSnapshotResponse) GetMeta() *SnapshotMeta {
+	if x, ok := x.GetMessage().(*DownloadSnapshotResponse_Meta); ok {
+		return x.Meta
 	}
+	return nil
 }
 
-func (x *LeadershipTransferRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LeadershipTransferRequest) ProtoMessage() {}
-
-func (x *LeadershipTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DownloadSnapshotResponse) GetData() []byte {
+	if x, ok := x.GetMessage().(*DownloadSnapshotResponse_Data); ok {
+		return x.Data
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LeadershipTransferRequest.ProtoReflect.Descriptor instead.
-func (*LeadershipTransferRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{18}
+func (x *DownloadSnapshotResponse) GetSha256() []byte {
+	if x, ok := x.GetMessage().(*DownloadSnapshotResponse_Sha256); ok {
+		return x.Sha256
+	}
+	return nil
 }
 
-type LeadershipTransferToServerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+type isDownloadSnapshotResponse_Message interface {
+	isDownloadSnapshotResponse_Message()
 }
 
-func (x *LeadershipTransferToServerRequest) Reset() {
-	*x = LeadershipTransferToServerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_raftadmin_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type DownloadSnapshotResponse_Meta struct {
+	Meta *SnapshotMeta `protobuf:"bytes,1,opt,name=meta,proto3,oneof"`
 }
 
-func (x *LeadershipTransferToServerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type DownloadSnapshotResponse_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
 }
 
-func (*LeadershipTransferToServerRequest) ProtoMessage() {}
-
-func (x *LeadershipTransferToServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raftadmin_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type DownloadSnapshotResponse_Sha256 struct {
+	Sha256 []byte `protobuf:"bytes,3,opt,name=sha256,proto3,oneof"`
 }
 
-// Deprecated: Use LeadershipTransferToServerRequest.ProtoReflect.Descriptor instead.
-func (*LeadershipTransferToServerRequest) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{19}
-}
+func (*DownloadSnapshotResponse_Meta) isDownloadSnapshotResponse_Message() {}
 
-func (x *LeadershipTransferToServerRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
+func (*DownloadSnapshotResponse_Data) isDownloadSnapshotResponse_Message() {}
 
-func (x *LeadershipTransferToServerRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
+func (*DownloadSnapshotResponse_Sha256) isDownloadSnapshotResponse_Message() {}
 
-type RemoveServerRequest struct {
+type EnterMaintenanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PreviousIndex uint64 `protobuf:"varint,2,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index,omitempty"`
+	// Included in the errors of rejected calls, e.g. "change freeze until Monday".
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *RemoveServerRequest) Reset() {
-	*x = RemoveServerRequest{}
+func (x *EnterMaintenanceRequest) Reset() {
+	*x = EnterMaintenanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1080,13 +1464,13 @@ func (x *RemoveServerRequest) Reset() {
 	}
 }
 
-func (x *RemoveServerRequest) String() string {
+func (x *EnterMaintenanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveServerRequest) ProtoMessage() {}
+func (*EnterMaintenanceRequest) ProtoMessage() {}
 
-func (x *RemoveServerRequest) ProtoReflect() protoreflect.Message {
+func (x *EnterMaintenanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1098,33 +1482,26 @@ func (x *RemoveServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveServerRequest.ProtoReflect.Descriptor instead.
-func (*RemoveServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnterMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*EnterMaintenanceRequest) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RemoveServerRequest) GetId() string {
+func (x *EnterMaintenanceRequest) GetReason() string {
 	if x != nil {
-		return x.Id
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *RemoveServerRequest) GetPreviousIndex() uint64 {
-	if x != nil {
-		return x.PreviousIndex
-	}
-	return 0
-}
-
-type ShutdownRequest struct {
+type EnterMaintenanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ShutdownRequest) Reset() {
-	*x = ShutdownRequest{}
+func (x *EnterMaintenanceResponse) Reset() {
+	*x = EnterMaintenanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1132,13 +1509,13 @@ func (x *ShutdownRequest) Reset() {
 	}
 }
 
-func (x *ShutdownRequest) String() string {
+func (x *EnterMaintenanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShutdownRequest) ProtoMessage() {}
+func (*EnterMaintenanceResponse) ProtoMessage() {}
 
-func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+func (x *EnterMaintenanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1150,19 +1527,19 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
-func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnterMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*EnterMaintenanceResponse) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{21}
 }
 
-type SnapshotRequest struct {
+type ExitMaintenanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SnapshotRequest) Reset() {
-	*x = SnapshotRequest{}
+func (x *ExitMaintenanceRequest) Reset() {
+	*x = ExitMaintenanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1170,13 +1547,13 @@ func (x *SnapshotRequest) Reset() {
 	}
 }
 
-func (x *SnapshotRequest) String() string {
+func (x *ExitMaintenanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnapshotRequest) ProtoMessage() {}
+func (*ExitMaintenanceRequest) ProtoMessage() {}
 
-func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
+func (x *ExitMaintenanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1188,19 +1565,19 @@ func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
-func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExitMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ExitMaintenanceRequest) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{22}
 }
 
-type StateRequest struct {
+type ExitMaintenanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StateRequest) Reset() {
-	*x = StateRequest{}
+func (x *ExitMaintenanceResponse) Reset() {
+	*x = ExitMaintenanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1208,13 +1585,13 @@ func (x *StateRequest) Reset() {
 	}
 }
 
-func (x *StateRequest) String() string {
+func (x *ExitMaintenanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StateRequest) ProtoMessage() {}
+func (*ExitMaintenanceResponse) ProtoMessage() {}
 
-func (x *StateRequest) ProtoReflect() protoreflect.Message {
+func (x *ExitMaintenanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,21 +1603,19 @@ func (x *StateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StateRequest.ProtoReflect.Descriptor instead.
-func (*StateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExitMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*ExitMaintenanceResponse) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{23}
 }
 
-type StateResponse struct {
+type GetConfigurationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	State StateResponse_State `protobuf:"varint,1,opt,name=state,proto3,enum=StateResponse_State" json:"state,omitempty"`
 }
 
-func (x *StateResponse) Reset() {
-	*x = StateResponse{}
+func (x *GetConfigurationRequest) Reset() {
+	*x = GetConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1248,13 +1623,13 @@ func (x *StateResponse) Reset() {
 	}
 }
 
-func (x *StateResponse) String() string {
+func (x *GetConfigurationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StateResponse) ProtoMessage() {}
+func (*GetConfigurationRequest) ProtoMessage() {}
 
-func (x *StateResponse) ProtoReflect() protoreflect.Message {
+func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1266,26 +1641,24 @@ func (x *StateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StateResponse.ProtoReflect.Descriptor instead.
-func (*StateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigurationRequest) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *StateResponse) GetState() StateResponse_State {
-	if x != nil {
-		return x.State
-	}
-	return StateResponse_FOLLOWER
-}
-
-type StatsRequest struct {
+type GetConfigurationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Servers []*GetConfigurationResponse_Server `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The index of the log entry that contains this configuration. raft v1.5 doesn't report it, so the server looks it up in the LogStore given with raftadmin.WithLogStore,
+	// or in the newest snapshot of the SnapshotStore given with raftadmin.WithSnapshotStore if the entry was compacted away. 0 if it's unknown.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *StatsRequest) Reset() {
-	*x = StatsRequest{}
+func (x *GetConfigurationResponse) Reset() {
+	*x = GetConfigurationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1293,13 +1666,13 @@ func (x *StatsRequest) Reset() {
 	}
 }
 
-func (x *StatsRequest) String() string {
+func (x *GetConfigurationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsRequest) ProtoMessage() {}
+func (*GetConfigurationResponse) ProtoMessage() {}
 
-func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1311,21 +1684,33 @@ func (x *StatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
-func (*StatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigurationResponse) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{25}
 }
 
-type StatsResponse struct {
+func (x *GetConfigurationResponse) GetServers() []*GetConfigurationResponse_Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *GetConfigurationResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type GetIndexesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Stats map[string]string `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *StatsResponse) Reset() {
-	*x = StatsResponse{}
+func (x *GetIndexesRequest) Reset() {
+	*x = GetIndexesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1333,13 +1718,13 @@ func (x *StatsResponse) Reset() {
 	}
 }
 
-func (x *StatsResponse) String() string {
+func (x *GetIndexesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse) ProtoMessage() {}
+func (*GetIndexesRequest) ProtoMessage() {}
 
-func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetIndexesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1351,26 +1736,28 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
-func (*StatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIndexesRequest.ProtoReflect.Descriptor instead.
+func (*GetIndexesRequest) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *StatsResponse) GetStats() map[string]string {
-	if x != nil {
-		return x.Stats
-	}
-	return nil
-}
-
-type VerifyLeaderRequest struct {
+type GetIndexesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The first index in the log store. Only set if the server has access to the log store.
+	FirstIndex        uint64 `protobuf:"varint,1,opt,name=first_index,json=firstIndex,proto3" json:"first_index,omitempty"`
+	LastIndex         uint64 `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	LastTerm          uint64 `protobuf:"varint,3,opt,name=last_term,json=lastTerm,proto3" json:"last_term,omitempty"`
+	CommitIndex       uint64 `protobuf:"varint,4,opt,name=commit_index,json=commitIndex,proto3" json:"commit_index,omitempty"`
+	AppliedIndex      uint64 `protobuf:"varint,5,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+	LastSnapshotIndex uint64 `protobuf:"varint,6,opt,name=last_snapshot_index,json=lastSnapshotIndex,proto3" json:"last_snapshot_index,omitempty"`
+	LastSnapshotTerm  uint64 `protobuf:"varint,7,opt,name=last_snapshot_term,json=lastSnapshotTerm,proto3" json:"last_snapshot_term,omitempty"`
 }
 
-func (x *VerifyLeaderRequest) Reset() {
-	*x = VerifyLeaderRequest{}
+func (x *GetIndexesResponse) Reset() {
+	*x = GetIndexesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1378,13 +1765,13 @@ func (x *VerifyLeaderRequest) Reset() {
 	}
 }
 
-func (x *VerifyLeaderRequest) String() string {
+func (x *GetIndexesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyLeaderRequest) ProtoMessage() {}
+func (*GetIndexesResponse) ProtoMessage() {}
 
-func (x *VerifyLeaderRequest) ProtoReflect() protoreflect.Message {
+func (x *GetIndexesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1396,23 +1783,75 @@ func (x *VerifyLeaderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyLeaderRequest.ProtoReflect.Descriptor instead.
-func (*VerifyLeaderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIndexesResponse.ProtoReflect.Descriptor instead.
+func (*GetIndexesResponse) Descriptor() ([]byte, []int) {
 	return file_raftadmin_proto_rawDescGZIP(), []int{27}
 }
 
-type GetConfigurationResponse_Server struct {
+func (x *GetIndexesResponse) GetFirstIndex() uint64 {
+	if x != nil {
+		return x.FirstIndex
+	}
+	return 0
+}
+
+func (x *GetIndexesResponse) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+func (x *GetIndexesResponse) GetLastTerm() uint64 {
+	if x != nil {
+		return x.LastTerm
+	}
+	return 0
+}
+
+func (x *GetIndexesResponse) GetCommitIndex() uint64 {
+	if x != nil {
+		return x.CommitIndex
+	}
+	return 0
+}
+
+func (x *GetIndexesResponse) GetAppliedIndex() uint64 {
+	if x != nil {
+		return x.AppliedIndex
+	}
+	return 0
+}
+
+func (x *GetIndexesResponse) GetLastSnapshotIndex() uint64 {
+	if x != nil {
+		return x.LastSnapshotIndex
+	}
+	return 0
+}
+
+func (x *GetIndexesResponse) GetLastSnapshotTerm() uint64 {
+	if x != nil {
+		return x.LastSnapshotTerm
+	}
+	return 0
+}
+
+type GetLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Suffrage GetConfigurationResponse_Server_Suffrage `protobuf:"varint,1,opt,name=suffrage,proto3,enum=GetConfigurationResponse_Server_Suffrage" json:"suffrage,omitempty"`
-	Id       string                                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Address  string                                   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	StartIndex uint64 `protobuf:"varint,1,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	// 0 means until the last index.
+	EndIndex    uint64 `protobuf:"varint,2,opt,name=end_index,json=endIndex,proto3" json:"end_index,omitempty"`
+	IncludeData bool   `protobuf:"varint,3,opt,name=include_data,json=includeData,proto3" json:"include_data,omitempty"`
+	// 0 means 1000. The server may return fewer entries to keep the response small.
+	MaxEntries uint64 `protobuf:"varint,4,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
 }
 
-func (x *GetConfigurationResponse_Server) Reset() {
-	*x = GetConfigurationResponse_Server{}
+func (x *GetLogsRequest) Reset() {
+	*x = GetLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raftadmin_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1420,13 +1859,13 @@ func (x *GetConfigurationResponse_Server) Reset() {
 	}
 }
 
-func (x *GetConfigurationResponse_Server) String() string {
+func (x *GetLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigurationResponse_Server) ProtoMessage() {}
+func (*GetLogsRequest) ProtoMessage() {}
 
-func (x *GetConfigurationResponse_Server) ProtoReflect() protoreflect.Message {
+func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_raftadmin_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1438,1440 +1877,11871 @@ func (x *GetConfigurationResponse_Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigurationResponse_Server.ProtoReflect.Descriptor instead.
-func (*GetConfigurationResponse_Server) Descriptor() ([]byte, []int) {
-	return file_raftadmin_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetConfigurationResponse_Server) GetSuffrage() GetConfigurationResponse_Server_Suffrage {
+func (x *GetLogsRequest) GetStartIndex() uint64 {
 	if x != nil {
-		return x.Suffrage
+		return x.StartIndex
 	}
-	return GetConfigurationResponse_Server_VOTER
+	return 0
 }
 
-func (x *GetConfigurationResponse_Server) GetId() string {
+func (x *GetLogsRequest) GetEndIndex() uint64 {
 	if x != nil {
-		return x.Id
+		return x.EndIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *GetConfigurationResponse_Server) GetAddress() string {
+func (x *GetLogsRequest) GetIncludeData() bool {
 	if x != nil {
-		return x.Address
+		return x.IncludeData
 	}
-	return ""
+	return false
 }
 
-var File_raftadmin_proto protoreflect.FileDescriptor
+func (x *GetLogsRequest) GetMaxEntries() uint64 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
 
-var file_raftadmin_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x72, 0x61, 0x66, 0x74, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x31, 0x0a, 0x06, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x0d, 0x41, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x22, 0x10, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x65, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4e, 0x6f,
-	0x6e, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69,
-	0x6f, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x45,
-	0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x14,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x10, 0x0a, 0x0e, 0x42, 0x61,
-	0x72, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x12,
-	0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76,
-	0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x1a, 0xab, 0x01,
-	0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66,
-	0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x66,
-	0x66, 0x72, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x08, 0x53, 0x75, 0x66,
-	0x66, 0x72, 0x61, 0x67, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x4e, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x53, 0x54, 0x41, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x22, 0x14, 0x0a, 0x12, 0x4c,
-	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x32, 0x0a, 0x13, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x78,
-	0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e, 0x69,
-	0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x11, 0x4c, 0x61, 0x73,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d,
-	0x0a, 0x21, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4c, 0x0a,
-	0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x11, 0x0a, 0x0f, 0x53,
-	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11,
-	0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x7b, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x14, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3e,
-	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4f, 0x4c, 0x4c, 0x4f,
-	0x57, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41,
-	0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x02,
-	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x22, 0x0e,
-	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7a,
-	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x15, 0x0a, 0x13, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x32, 0xe7, 0x07, 0x0a, 0x09, 0x52, 0x61, 0x66, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
-	0x2d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x13,
-	0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x27,
-	0x0a, 0x08, 0x41, 0x64, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x41, 0x64, 0x64,
-	0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x46,
-	0x75, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c,
-	0x6f, 0x67, 0x12, 0x10, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12,
-	0x25, 0x0a, 0x07, 0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x42, 0x61, 0x72,
-	0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0b, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x6f,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
-	0x13, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09,
-	0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x11, 0x2e, 0x4c, 0x61, 0x73, 0x74,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c,
-	0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x4c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3b, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68,
-	0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x1a,
-	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
-	0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07,
-	0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x08, 0x53, 0x68,
-	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x10, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
-	0x10, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x0d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x2f, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x14, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x00, 0x12, 0x22, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12, 0x07, 0x2e, 0x46, 0x75, 0x74,
-	0x75, 0x72, 0x65, 0x1a, 0x0e, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x0f, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a, 0x69, 0x6c, 0x6c, 0x65, 0x2f,
-	0x73, 0x6b, 0x79, 0x6e, 0x65, 0x74, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x72, 0x61,
-	0x66, 0x74, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+type GetLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*GetLogsResponse_Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// If not 0, the range was cut short and the remaining entries can be fetched by passing this as start_index.
+	NextIndex uint64 `protobuf:"varint,2,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
 }
 
-var (
-	file_raftadmin_proto_rawDescOnce sync.Once
-	file_raftadmin_proto_rawDescData = file_raftadmin_proto_rawDesc
-)
+func (x *GetLogsResponse) Reset() {
+	*x = GetLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_raftadmin_proto_rawDescGZIP() []byte {
-	file_raftadmin_proto_rawDescOnce.Do(func() {
-		file_raftadmin_proto_rawDescData = protoimpl.X.CompressGZIP(file_raftadmin_proto_rawDescData)
-	})
-	return file_raftadmin_proto_rawDescData
+func (x *GetLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_raftadmin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_raftadmin_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
-var file_raftadmin_proto_goTypes = []interface{}{
-	(GetConfigurationResponse_Server_Suffrage)(0), // 0: GetConfigurationResponse.Server.Suffrage
-	(StateResponse_State)(0),                      // 1: StateResponse.State
-	(*Future)(nil),                                // 2: Future
-	(*AwaitResponse)(nil),                         // 3: AwaitResponse
-	(*ForgetResponse)(nil),                        // 4: ForgetResponse
-	(*AddVoterRequest)(nil),                       // 5: AddVoterRequest
-	(*AddNonvoterRequest)(nil),                    // 6: AddNonvoterRequest
-	(*ApplyLogRequest)(nil),                       // 7: ApplyLogRequest
-	(*AppliedIndexRequest)(nil),                   // 8: AppliedIndexRequest
-	(*AppliedIndexResponse)(nil),                  // 9: AppliedIndexResponse
-	(*BarrierRequest)(nil),                        // 10: BarrierRequest
-	(*DemoteVoterRequest)(nil),                    // 11: DemoteVoterRequest
-	(*GetConfigurationRequest)(nil),               // 12: GetConfigurationRequest
-	(*GetConfigurationResponse)(nil),              // 13: GetConfigurationResponse
-	(*LastContactRequest)(nil),                    // 14: LastContactRequest
-	(*LastContactResponse)(nil),                   // 15: LastContactResponse
-	(*LastIndexRequest)(nil),                      // 16: LastIndexRequest
-	(*LastIndexResponse)(nil),                     // 17: LastIndexResponse
-	(*LeaderRequest)(nil),                         // 18: LeaderRequest
-	(*LeaderResponse)(nil),                        // 19: LeaderResponse
-	(*LeadershipTransferRequest)(nil),             // 20: LeadershipTransferRequest
-	(*LeadershipTransferToServerRequest)(nil),     // 21: LeadershipTransferToServerRequest
-	(*RemoveServerRequest)(nil),                   // 22: RemoveServerRequest
-	(*ShutdownRequest)(nil),                       // 23: ShutdownRequest
-	(*SnapshotRequest)(nil),                       // 24: SnapshotRequest
-	(*StateRequest)(nil),                          // 25: StateRequest
-	(*StateResponse)(nil),                         // 26: StateResponse
-	(*StatsRequest)(nil),                          // 27: StatsRequest
-	(*StatsResponse)(nil),                         // 28: StatsResponse
-	(*VerifyLeaderRequest)(nil),                   // 29: VerifyLeaderRequest
-	(*GetConfigurationResponse_Server)(nil),       // 30: GetConfigurationResponse.Server
-	nil,                                           // 31: StatsResponse.StatsEntry
+func (*GetLogsResponse) ProtoMessage() {}
+
+func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_raftadmin_proto_depIdxs = []int32{
-	30, // 0: GetConfigurationResponse.servers:type_name -> GetConfigurationResponse.Server
-	1,  // 1: StateResponse.state:type_name -> StateResponse.State
-	31, // 2: StatsResponse.stats:type_name -> StatsResponse.StatsEntry
-	0,  // 3: GetConfigurationResponse.Server.suffrage:type_name -> GetConfigurationResponse.Server.Suffrage
-	6,  // 4: RaftAdmin.AddNonvoter:input_type -> AddNonvoterRequest
-	5,  // 5: RaftAdmin.AddVoter:input_type -> AddVoterRequest
-	8,  // 6: RaftAdmin.AppliedIndex:input_type -> AppliedIndexRequest
-	7,  // 7: RaftAdmin.ApplyLog:input_type -> ApplyLogRequest
-	10, // 8: RaftAdmin.Barrier:input_type -> BarrierRequest
-	11, // 9: RaftAdmin.DemoteVoter:input_type -> DemoteVoterRequest
-	12, // 10: RaftAdmin.GetConfiguration:input_type -> GetConfigurationRequest
-	14, // 11: RaftAdmin.LastContact:input_type -> LastContactRequest
-	16, // 12: RaftAdmin.LastIndex:input_type -> LastIndexRequest
-	18, // 13: RaftAdmin.Leader:input_type -> LeaderRequest
-	20, // 14: RaftAdmin.LeadershipTransfer:input_type -> LeadershipTransferRequest
-	21, // 15: RaftAdmin.LeadershipTransferToServer:input_type -> LeadershipTransferToServerRequest
-	22, // 16: RaftAdmin.RemoveServer:input_type -> RemoveServerRequest
-	23, // 17: RaftAdmin.Shutdown:input_type -> ShutdownRequest
-	24, // 18: RaftAdmin.Snapshot:input_type -> SnapshotRequest
-	25, // 19: RaftAdmin.State:input_type -> StateRequest
-	27, // 20: RaftAdmin.Stats:input_type -> StatsRequest
-	29, // 21: RaftAdmin.VerifyLeader:input_type -> VerifyLeaderRequest
-	2,  // 22: RaftAdmin.Await:input_type -> Future
-	2,  // 23: RaftAdmin.Forget:input_type -> Future
-	2,  // 24: RaftAdmin.AddNonvoter:output_type -> Future
-	2,  // 25: RaftAdmin.AddVoter:output_type -> Future
-	9,  // 26: RaftAdmin.AppliedIndex:output_type -> AppliedIndexResponse
-	2,  // 27: RaftAdmin.ApplyLog:output_type -> Future
-	2,  // 28: RaftAdmin.Barrier:output_type -> Future
-	2,  // 29: RaftAdmin.DemoteVoter:output_type -> Future
-	13, // 30: RaftAdmin.GetConfiguration:output_type -> GetConfigurationResponse
-	15, // 31: RaftAdmin.LastContact:output_type -> LastContactResponse
-	17, // 32: RaftAdmin.LastIndex:output_type -> LastIndexResponse
-	19, // 33: RaftAdmin.Leader:output_type -> LeaderResponse
-	2,  // 34: RaftAdmin.LeadershipTransfer:output_type -> Future
-	2,  // 35: RaftAdmin.LeadershipTransferToServer:output_type -> Future
-	2,  // 36: RaftAdmin.RemoveServer:output_type -> Future
-	2,  // 37: RaftAdmin.Shutdown:output_type -> Future
-	2,  // 38: RaftAdmin.Snapshot:output_type -> Future
-	26, // 39: RaftAdmin.State:output_type -> StateResponse
-	28, // 40: RaftAdmin.Stats:output_type -> StatsResponse
-	2,  // 41: RaftAdmin.VerifyLeader:output_type -> Future
-	3,  // 42: RaftAdmin.Await:output_type -> AwaitResponse
-	4,  // 43: RaftAdmin.Forget:output_type -> ForgetResponse
-	24, // [24:44] is the sub-list for method output_type
-	4,  // [4:24] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{29}
 }
 
-func init() { file_raftadmin_proto_init() }
-func file_raftadmin_proto_init() {
-	if File_raftadmin_proto != nil {
-		return
+func (x *GetLogsResponse) GetLogs() []*GetLogsResponse_Log {
+	if x != nil {
+		return x.Logs
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_raftadmin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Future); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_raftadmin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AwaitResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_raftadmin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForgetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *GetLogsResponse) GetNextIndex() uint64 {
+	if x != nil {
+		return x.NextIndex
+	}
+	return 0
+}
+
+type GetMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMetadataRequest) Reset() {
+	*x = GetMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataRequest) ProtoMessage() {}
+
+func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddVoterRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{30}
+}
+
+type GetMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetMetadataResponse) Reset() {
+	*x = GetMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataResponse) ProtoMessage() {}
+
+func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddNonvoterRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMetadataResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetReloadableConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetReloadableConfigRequest) Reset() {
+	*x = GetReloadableConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReloadableConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReloadableConfigRequest) ProtoMessage() {}
+
+func (x *GetReloadableConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyLogRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReloadableConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetReloadableConfigRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{32}
+}
+
+type GetReloadableConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *ReloadableConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *GetReloadableConfigResponse) Reset() {
+	*x = GetReloadableConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReloadableConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReloadableConfigResponse) ProtoMessage() {}
+
+func (x *GetReloadableConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppliedIndexRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_raftadmin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppliedIndexResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_raftadmin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BarrierRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReloadableConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetReloadableConfigResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetReloadableConfigResponse) GetConfig() *ReloadableConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetStableStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStableStoreRequest) Reset() {
+	*x = GetStableStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStableStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStableStoreRequest) ProtoMessage() {}
+
+func (x *GetStableStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DemoteVoterRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStableStoreRequest.ProtoReflect.Descriptor instead.
+func (*GetStableStoreRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{34}
+}
+
+type GetStableStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The values raft persisted in its StableStore. They are 0 or empty if raft hasn't written them yet.
+	CurrentTerm  uint64 `protobuf:"varint,1,opt,name=current_term,json=currentTerm,proto3" json:"current_term,omitempty"`
+	LastVoteTerm uint64 `protobuf:"varint,2,opt,name=last_vote_term,json=lastVoteTerm,proto3" json:"last_vote_term,omitempty"`
+	// The address of the server this node voted for in last_vote_term.
+	LastVoteCandidate string `protobuf:"bytes,3,opt,name=last_vote_candidate,json=lastVoteCandidate,proto3" json:"last_vote_candidate,omitempty"`
+}
+
+func (x *GetStableStoreResponse) Reset() {
+	*x = GetStableStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStableStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStableStoreResponse) ProtoMessage() {}
+
+func (x *GetStableStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigurationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStableStoreResponse.ProtoReflect.Descriptor instead.
+func (*GetStableStoreResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetStableStoreResponse) GetCurrentTerm() uint64 {
+	if x != nil {
+		return x.CurrentTerm
+	}
+	return 0
+}
+
+func (x *GetStableStoreResponse) GetLastVoteTerm() uint64 {
+	if x != nil {
+		return x.LastVoteTerm
+	}
+	return 0
+}
+
+func (x *GetStableStoreResponse) GetLastVoteCandidate() string {
+	if x != nil {
+		return x.LastVoteCandidate
+	}
+	return ""
+}
+
+type GracefulShutdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long to wait for the leadership transfer. 0 means until raft gives up on it.
+	DrainTimeoutMs uint64 `protobuf:"varint,1,opt,name=drain_timeout_ms,json=drainTimeoutMs,proto3" json:"drain_timeout_ms,omitempty"`
+	// Shut down even if the leadership transfer failed.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *GracefulShutdownRequest) Reset() {
+	*x = GracefulShutdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GracefulShutdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GracefulShutdownRequest) ProtoMessage() {}
+
+func (x *GracefulShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigurationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GracefulShutdownRequest.ProtoReflect.Descriptor instead.
+func (*GracefulShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GracefulShutdownRequest) GetDrainTimeoutMs() uint64 {
+	if x != nil {
+		return x.DrainTimeoutMs
+	}
+	return 0
+}
+
+func (x *GracefulShutdownRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type LastContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LastContactRequest) Reset() {
+	*x = LastContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastContactRequest) ProtoMessage() {}
+
+func (x *LastContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastContactRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastContactRequest.ProtoReflect.Descriptor instead.
+func (*LastContactRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{37}
+}
+
+type LastContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnixNano int64 `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+}
+
+func (x *LastContactResponse) Reset() {
+	*x = LastContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastContactResponse) ProtoMessage() {}
+
+func (x *LastContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastContactResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_raftadmin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastIndexRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastContactResponse.ProtoReflect.Descriptor instead.
+func (*LastContactResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LastContactResponse) GetUnixNano() int64 {
+	if x != nil {
+		return x.UnixNano
+	}
+	return 0
+}
+
+type LastIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LastIndexRequest) Reset() {
+	*x = LastIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastIndexRequest) ProtoMessage() {}
+
+func (x *LastIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastIndexResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastIndexRequest.ProtoReflect.Descriptor instead.
+func (*LastIndexRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{39}
+}
+
+type LastIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *LastIndexResponse) Reset() {
+	*x = LastIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastIndexResponse) ProtoMessage() {}
+
+func (x *LastIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaderRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastIndexResponse.ProtoReflect.Descriptor instead.
+func (*LastIndexResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LastIndexResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type LastSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LastSnapshotRequest) Reset() {
+	*x = LastSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastSnapshotRequest) ProtoMessage() {}
+
+func (x *LastSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaderResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*LastSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{41}
+}
+
+type LastSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 if this node has no snapshot yet.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Term  uint64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	// Number of log entries after the snapshot.
+	EntriesSince uint64 `protobuf:"varint,3,opt,name=entries_since,json=entriesSince,proto3" json:"entries_since,omitempty"`
+	// The metadata of the snapshot. Only set if the server has access to the SnapshotStore.
+	Meta *SnapshotMeta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Time since the snapshot was created. Only set if meta is set and the SnapshotStore records creation times.
+	AgeNanos int64 `protobuf:"varint,5,opt,name=age_nanos,json=ageNanos,proto3" json:"age_nanos,omitempty"`
+}
+
+func (x *LastSnapshotResponse) Reset() {
+	*x = LastSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastSnapshotResponse) ProtoMessage() {}
+
+func (x *LastSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeadershipTransferRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*LastSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LastSnapshotResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LastSnapshotResponse) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *LastSnapshotResponse) GetEntriesSince() uint64 {
+	if x != nil {
+		return x.EntriesSince
+	}
+	return 0
+}
+
+func (x *LastSnapshotResponse) GetMeta() *SnapshotMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *LastSnapshotResponse) GetAgeNanos() int64 {
+	if x != nil {
+		return x.AgeNanos
+	}
+	return 0
+}
+
+type LeaderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaderRequest) Reset() {
+	*x = LeaderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderRequest) ProtoMessage() {}
+
+func (x *LeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeadershipTransferToServerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_raftadmin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveServerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderRequest.ProtoReflect.Descriptor instead.
+func (*LeaderRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{43}
+}
+
+type LeaderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *LeaderResponse) Reset() {
+	*x = LeaderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderResponse) ProtoMessage() {}
+
+func (x *LeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShutdownRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderResponse.ProtoReflect.Descriptor instead.
+func (*LeaderResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LeaderResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LeaderResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type LeadershipTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeadershipTransferRequest) Reset() {
+	*x = LeadershipTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeadershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadershipTransferRequest) ProtoMessage() {}
+
+func (x *LeadershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*LeadershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{45}
+}
+
+type LeadershipTransferToServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *LeadershipTransferToServerRequest) Reset() {
+	*x = LeadershipTransferToServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeadershipTransferToServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadershipTransferToServerRequest) ProtoMessage() {}
+
+func (x *LeadershipTransferToServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadershipTransferToServerRequest.ProtoReflect.Descriptor instead.
+func (*LeadershipTransferToServerRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LeadershipTransferToServerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LeadershipTransferToServerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ListClustersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListClustersRequest) Reset() {
+	*x = ListClustersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersRequest) ProtoMessage() {}
+
+func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{47}
+}
+
+type ListClustersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sorted by id.
+	Clusters []*ListClustersResponse_Cluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (x *ListClustersResponse) Reset() {
+	*x = ListClustersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersResponse) ProtoMessage() {}
+
+func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListClustersResponse) GetClusters() []*ListClustersResponse_Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type ListOperationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return calls to this method, like "RemoveServer".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Return at most this many operations. 0 means all.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListOperationsRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListOperationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Newest first.
+	Operations []*ListOperationsResponse_Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse) ProtoMessage() {}
+
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyLeaderRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListOperationsResponse) GetOperations() []*ListOperationsResponse_Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type ListPendingFuturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPendingFuturesRequest) Reset() {
+	*x = ListPendingFuturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingFuturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingFuturesRequest) ProtoMessage() {}
+
+func (x *ListPendingFuturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_raftadmin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigurationResponse_Server); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingFuturesRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingFuturesRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{51}
+}
+
+type ListPendingFuturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sorted by creation time, oldest first.
+	Futures []*ListPendingFuturesResponse_PendingFuture `protobuf:"bytes,1,rep,name=futures,proto3" json:"futures,omitempty"`
+}
+
+func (x *ListPendingFuturesResponse) Reset() {
+	*x = ListPendingFuturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingFuturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingFuturesResponse) ProtoMessage() {}
+
+func (x *ListPendingFuturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_raftadmin_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   30,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_raftadmin_proto_goTypes,
-		DependencyIndexes: file_raftadmin_proto_depIdxs,
-		EnumInfos:         file_raftadmin_proto_enumTypes,
-		MessageInfos:      file_raftadmin_proto_msgTypes,
-	}.Build()
-	File_raftadmin_proto = out.File
-	file_raftadmin_proto_rawDesc = nil
-	file_raftadmin_proto_goTypes = nil
-	file_raftadmin_proto_depIdxs = nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingFuturesResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingFuturesResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListPendingFuturesResponse) GetFutures() []*ListPendingFuturesResponse_PendingFuture {
+	if x != nil {
+		return x.Futures
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{53}
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Newest first.
+	Snapshots []*SnapshotMeta `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*SnapshotMeta {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type LogStoreStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LogStoreStatsRequest) Reset() {
+	*x = LogStoreStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStoreStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStoreStatsRequest) ProtoMessage() {}
+
+func (x *LogStoreStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStoreStatsRequest.ProtoReflect.Descriptor instead.
+func (*LogStoreStatsRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{55}
+}
+
+type LogStoreStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstIndex uint64 `protobuf:"varint,1,opt,name=first_index,json=firstIndex,proto3" json:"first_index,omitempty"`
+	LastIndex  uint64 `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	// Only set if the LogStore is from raft-boltdb or implements raftadmin.LogStoreStatser.
+	Storage *LogStoreStatsResponse_Storage `protobuf:"bytes,3,opt,name=storage,proto3" json:"storage,omitempty"`
+}
+
+func (x *LogStoreStatsResponse) Reset() {
+	*x = LogStoreStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStoreStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStoreStatsResponse) ProtoMessage() {}
+
+func (x *LogStoreStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStoreStatsResponse.ProtoReflect.Descriptor instead.
+func (*LogStoreStatsResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LogStoreStatsResponse) GetFirstIndex() uint64 {
+	if x != nil {
+		return x.FirstIndex
+	}
+	return 0
+}
+
+func (x *LogStoreStatsResponse) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+func (x *LogStoreStatsResponse) GetStorage() *LogStoreStatsResponse_Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+type ObserveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The types of observations to send. Empty means all of them.
+	Types []ObserveRequest_Type `protobuf:"varint,1,rep,packed,name=types,proto3,enum=ObserveRequest_Type" json:"types,omitempty"`
+}
+
+func (x *ObserveRequest) Reset() {
+	*x = ObserveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObserveRequest) ProtoMessage() {}
+
+func (x *ObserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObserveRequest.ProtoReflect.Descriptor instead.
+func (*ObserveRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ObserveRequest) GetTypes() []ObserveRequest_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type Observation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnixNano int64 `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+	// Types that are assignable to Observation:
+	//	*Observation_Leader_
+	//	*Observation_Peer_
+	//	*Observation_FailedHeartbeat_
+	//	*Observation_ResumedHeartbeat_
+	//	*Observation_RequestVote_
+	//	*Observation_State
+	Observation isObservation_Observation `protobuf_oneof:"observation"`
+}
+
+func (x *Observation) Reset() {
+	*x = Observation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Observation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation) ProtoMessage() {}
+
+func (x *Observation) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation.ProtoReflect.Descriptor instead.
+func (*Observation) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Observation) GetUnixNano() int64 {
+	if x != nil {
+		return x.UnixNano
+	}
+	return 0
+}
+
+func (m *Observation) GetObservation() isObservation_Observation {
+	if m != nil {
+		return m.Observation
+	}
+	return nil
+}
+
+func (x *Observation) GetLeader() *Observation_Leader {
+	if x, ok := x.GetObservation().(*Observation_Leader_); ok {
+		return x.Leader
+	}
+	return nil
+}
+
+func (x *Observation) GetPeer() *Observation_Peer {
+	if x, ok := x.GetObservation().(*Observation_Peer_); ok {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *Observation) GetFailedHeartbeat() *Observation_FailedHeartbeat {
+	if x, ok := x.GetObservation().(*Observation_FailedHeartbeat_); ok {
+		return x.FailedHeartbeat
+	}
+	return nil
+}
+
+func (x *Observation) GetResumedHeartbeat() *Observation_ResumedHeartbeat {
+	if x, ok := x.GetObservation().(*Observation_ResumedHeartbeat_); ok {
+		return x.ResumedHeartbeat
+	}
+	return nil
+}
+
+func (x *Observation) GetRequestVote() *Observation_RequestVote {
+	if x, ok := x.GetObservation().(*Observation_RequestVote_); ok {
+		return x.RequestVote
+	}
+	return nil
+}
+
+func (x *Observation) GetState() StateResponse_State {
+	if x, ok := x.GetObservation().(*Observation_State); ok {
+		return x.State
+	}
+	return StateResponse_FOLLOWER
+}
+
+type isObservation_Observation interface {
+	isObservation_Observation()
+}
+
+type Observation_Leader_ struct {
+	Leader *Observation_Leader `protobuf:"bytes,2,opt,name=leader,proto3,oneof"`
+}
+
+type Observation_Peer_ struct {
+	Peer *Observation_Peer `protobuf:"bytes,3,opt,name=peer,proto3,oneof"`
+}
+
+type Observation_FailedHeartbeat_ struct {
+	FailedHeartbeat *Observation_FailedHeartbeat `protobuf:"bytes,4,opt,name=failed_heartbeat,json=failedHeartbeat,proto3,oneof"`
+}
+
+type Observation_ResumedHeartbeat_ struct {
+	ResumedHeartbeat *Observation_ResumedHeartbeat `protobuf:"bytes,5,opt,name=resumed_heartbeat,json=resumedHeartbeat,proto3,oneof"`
+}
+
+type Observation_RequestVote_ struct {
+	RequestVote *Observation_RequestVote `protobuf:"bytes,6,opt,name=request_vote,json=requestVote,proto3,oneof"`
+}
+
+type Observation_State struct {
+	// The new state of this node.
+	State StateResponse_State `protobuf:"varint,7,opt,name=state,proto3,enum=StateResponse_State,oneof"`
+}
+
+func (*Observation_Leader_) isObservation_Observation() {}
+
+func (*Observation_Peer_) isObservation_Observation() {}
+
+func (*Observation_FailedHeartbeat_) isObservation_Observation() {}
+
+func (*Observation_ResumedHeartbeat_) isObservation_Observation() {}
+
+func (*Observation_RequestVote_) isObservation_Observation() {}
+
+func (*Observation_State) isObservation_Observation() {}
+
+type PeerLastContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PeerLastContactRequest) Reset() {
+	*x = PeerLastContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerLastContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerLastContactRequest) ProtoMessage() {}
+
+func (x *PeerLastContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerLastContactRequest.ProtoReflect.Descriptor instead.
+func (*PeerLastContactRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{59}
+}
+
+type PeerLastContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*PeerLastContactResponse_Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *PeerLastContactResponse) Reset() {
+	*x = PeerLastContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerLastContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerLastContactResponse) ProtoMessage() {}
+
+func (x *PeerLastContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerLastContactResponse.ProtoReflect.Descriptor instead.
+func (*PeerLastContactResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PeerLastContactResponse) GetPeers() []*PeerLastContactResponse_Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+// ReloadableConfig mirrors raft.ReloadableConfig. Durations are in nanoseconds.
+type ReloadableConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrailingLogs          uint64 `protobuf:"varint,1,opt,name=trailing_logs,json=trailingLogs,proto3" json:"trailing_logs,omitempty"`
+	SnapshotIntervalNanos int64  `protobuf:"varint,2,opt,name=snapshot_interval_nanos,json=snapshotIntervalNanos,proto3" json:"snapshot_interval_nanos,omitempty"`
+	SnapshotThreshold     uint64 `protobuf:"varint,3,opt,name=snapshot_threshold,json=snapshotThreshold,proto3" json:"snapshot_threshold,omitempty"`
+	HeartbeatTimeoutNanos int64  `protobuf:"varint,4,opt,name=heartbeat_timeout_nanos,json=heartbeatTimeoutNanos,proto3" json:"heartbeat_timeout_nanos,omitempty"`
+	ElectionTimeoutNanos  int64  `protobuf:"varint,5,opt,name=election_timeout_nanos,json=electionTimeoutNanos,proto3" json:"election_timeout_nanos,omitempty"`
+}
+
+func (x *ReloadableConfig) Reset() {
+	*x = ReloadableConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadableConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadableConfig) ProtoMessage() {}
+
+func (x *ReloadableConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadableConfig.ProtoReflect.Descriptor instead.
+func (*ReloadableConfig) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReloadableConfig) GetTrailingLogs() uint64 {
+	if x != nil {
+		return x.TrailingLogs
+	}
+	return 0
+}
+
+func (x *ReloadableConfig) GetSnapshotIntervalNanos() int64 {
+	if x != nil {
+		return x.SnapshotIntervalNanos
+	}
+	return 0
+}
+
+func (x *ReloadableConfig) GetSnapshotThreshold() uint64 {
+	if x != nil {
+		return x.SnapshotThreshold
+	}
+	return 0
+}
+
+func (x *ReloadableConfig) GetHeartbeatTimeoutNanos() int64 {
+	if x != nil {
+		return x.HeartbeatTimeoutNanos
+	}
+	return 0
+}
+
+func (x *ReloadableConfig) GetElectionTimeoutNanos() int64 {
+	if x != nil {
+		return x.ElectionTimeoutNanos
+	}
+	return 0
+}
+
+type QuorumStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QuorumStatusRequest) Reset() {
+	*x = QuorumStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuorumStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuorumStatusRequest) ProtoMessage() {}
+
+func (x *QuorumStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuorumStatusRequest.ProtoReflect.Descriptor instead.
+func (*QuorumStatusRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{62}
+}
+
+type QuorumStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voters uint64 `protobuf:"varint,1,opt,name=voters,proto3" json:"voters,omitempty"`
+	// The number of voters needed for a majority.
+	QuorumSize uint64 `protobuf:"varint,2,opt,name=quorum_size,json=quorumSize,proto3" json:"quorum_size,omitempty"`
+	// The number of voters the leader's heartbeats are reaching, including the leader itself.
+	ReachableVoters uint64 `protobuf:"varint,3,opt,name=reachable_voters,json=reachableVoters,proto3" json:"reachable_voters,omitempty"`
+	// IDs of the voters the leader's heartbeats are failing for.
+	UnreachableVoters []string `protobuf:"bytes,4,rep,name=unreachable_voters,json=unreachableVoters,proto3" json:"unreachable_voters,omitempty"`
+	// How many more voters can fail before quorum is lost. Negative if the leader can't reach a quorum anymore.
+	FailureTolerance int64 `protobuf:"varint,5,opt,name=failure_tolerance,json=failureTolerance,proto3" json:"failure_tolerance,omitempty"`
+}
+
+func (x *QuorumStatusResponse) Reset() {
+	*x = QuorumStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuorumStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuorumStatusResponse) ProtoMessage() {}
+
+func (x *QuorumStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuorumStatusResponse.ProtoReflect.Descriptor instead.
+func (*QuorumStatusResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *QuorumStatusResponse) GetVoters() uint64 {
+	if x != nil {
+		return x.Voters
+	}
+	return 0
+}
+
+func (x *QuorumStatusResponse) GetQuorumSize() uint64 {
+	if x != nil {
+		return x.QuorumSize
+	}
+	return 0
+}
+
+func (x *QuorumStatusResponse) GetReachableVoters() uint64 {
+	if x != nil {
+		return x.ReachableVoters
+	}
+	return 0
+}
+
+func (x *QuorumStatusResponse) GetUnreachableVoters() []string {
+	if x != nil {
+		return x.UnreachableVoters
+	}
+	return nil
+}
+
+func (x *QuorumStatusResponse) GetFailureTolerance() int64 {
+	if x != nil {
+		return x.FailureTolerance
+	}
+	return 0
+}
+
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fields that are 0 keep their current value.
+	Config *ReloadableConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRequest) ProtoMessage() {}
+
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReloadConfigRequest) GetConfig() *ReloadableConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ReloadConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The configuration after the reload.
+	Config *ReloadableConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ReloadConfigResponse) Reset() {
+	*x = ReloadConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigResponse) ProtoMessage() {}
+
+func (x *ReloadConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReloadConfigResponse) GetConfig() *ReloadableConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type RemoveServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PreviousIndex uint64 `protobuf:"varint,2,opt,name=previous_index,json=previousIndex,proto3" json:"previous_index,omitempty"`
+	// Set this instead of id to remove the server with this address. The server looks up its ID in the current configuration.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// How long raft may take to start the operation. 0 means the deadline of the RPC, if any.
+	TimeoutMs uint64 `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Required by servers using raftadmin.WithConfirmation, see RemoveServer.
+	ConfirmationToken string `protobuf:"bytes,5,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	// Remove the server even if it is the leader, or if servers using raftadmin.WithQuorumChecks would reject it. Without it, removing the leader fails with FAILED_PRECONDITION; transfer leadership away first.
+	Force bool `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *RemoveServerRequest) Reset() {
+	*x = RemoveServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveServerRequest) ProtoMessage() {}
+
+func (x *RemoveServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveServerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveServerRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RemoveServerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveServerRequest) GetPreviousIndex() uint64 {
+	if x != nil {
+		return x.PreviousIndex
+	}
+	return 0
+}
+
+func (x *RemoveServerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RemoveServerRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *RemoveServerRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+func (x *RemoveServerRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ReplicationStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplicationStatusRequest) Reset() {
+	*x = ReplicationStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationStatusRequest) ProtoMessage() {}
+
+func (x *ReplicationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationStatusRequest.ProtoReflect.Descriptor instead.
+func (*ReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{67}
+}
+
+type ReplicationStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastIndex   uint64                                `protobuf:"varint,1,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	CommitIndex uint64                                `protobuf:"varint,2,opt,name=commit_index,json=commitIndex,proto3" json:"commit_index,omitempty"`
+	Followers   []*ReplicationStatusResponse_Follower `protobuf:"bytes,3,rep,name=followers,proto3" json:"followers,omitempty"`
+}
+
+func (x *ReplicationStatusResponse) Reset() {
+	*x = ReplicationStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationStatusResponse) ProtoMessage() {}
+
+func (x *ReplicationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationStatusResponse.ProtoReflect.Descriptor instead.
+func (*ReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReplicationStatusResponse) GetLastIndex() uint64 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+func (x *ReplicationStatusResponse) GetCommitIndex() uint64 {
+	if x != nil {
+		return x.CommitIndex
+	}
+	return 0
+}
+
+func (x *ReplicationStatusResponse) GetFollowers() []*ReplicationStatusResponse_Follower {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+type RestoreSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The first message must contain the metadata, followed by the data in chunks, followed by the SHA-256 of the data.
+	// The server only restores the snapshot once it has received all data and it matches the size and checksum.
+	//
+	// Types that are assignable to Message:
+	//	*RestoreSnapshotRequest_Meta
+	//	*RestoreSnapshotRequest_Data
+	//	*RestoreSnapshotRequest_Sha256
+	Message isRestoreSnapshotRequest_Message `protobuf_oneof:"message"`
+	// Set on the first message, for servers using raftadmin.WithConfirmation.
+	ConfirmationToken string `protobuf:"bytes,4,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+}
+
+func (x *RestoreSnapshotRequest) Reset() {
+	*x = RestoreSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{69}
+}
+
+func (m *RestoreSnapshotRequest) GetMessage() isRestoreSnapshotRequest_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *RestoreSnapshotRequest) GetMeta() *SnapshotMeta {
+	if x, ok := x.GetMessage().(*RestoreSnapshotRequest_Meta); ok {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RestoreSnapshotRequest) GetData() []byte {
+	if x, ok := x.GetMessage().(*RestoreSnapshotRequest_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RestoreSnapshotRequest) GetSha256() []byte {
+	if x, ok := x.GetMessage().(*RestoreSnapshotRequest_Sha256); ok {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *RestoreSnapshotRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+type isRestoreSnapshotRequest_Message interface {
+	isRestoreSnapshotRequest_Message()
+}
+
+type RestoreSnapshotRequest_Meta struct {
+	Meta *SnapshotMeta `protobuf:"bytes,1,opt,name=meta,proto3,oneof"`
+}
+
+type RestoreSnapshotRequest_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+type RestoreSnapshotRequest_Sha256 struct {
+	Sha256 []byte `protobuf:"bytes,3,opt,name=sha256,proto3,oneof"`
+}
+
+func (*RestoreSnapshotRequest_Meta) isRestoreSnapshotRequest_Message() {}
+
+func (*RestoreSnapshotRequest_Data) isRestoreSnapshotRequest_Message() {}
+
+func (*RestoreSnapshotRequest_Sha256) isRestoreSnapshotRequest_Message() {}
+
+type RestoreSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreSnapshotResponse) Reset() {
+	*x = RestoreSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{70}
+}
+
+type ServerHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only report this server. Leave empty for all of them.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The maximum number of entries a follower can be behind the leader's last index and still be healthy. 0 means 250.
+	MaxLag uint64 `protobuf:"varint,2,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+	// How long heartbeats to a server must have succeeded for it to be healthy. 0 means 10 seconds.
+	MinStableMs uint64 `protobuf:"varint,3,opt,name=min_stable_ms,json=minStableMs,proto3" json:"min_stable_ms,omitempty"`
+}
+
+func (x *ServerHealthRequest) Reset() {
+	*x = ServerHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerHealthRequest) ProtoMessage() {}
+
+func (x *ServerHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerHealthRequest.ProtoReflect.Descriptor instead.
+func (*ServerHealthRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ServerHealthRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServerHealthRequest) GetMaxLag() uint64 {
+	if x != nil {
+		return x.MaxLag
+	}
+	return 0
+}
+
+func (x *ServerHealthRequest) GetMinStableMs() uint64 {
+	if x != nil {
+		return x.MinStableMs
+	}
+	return 0
+}
+
+type ServerHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*ServerHealthResponse_Server `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+}
+
+func (x *ServerHealthResponse) Reset() {
+	*x = ServerHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerHealthResponse) ProtoMessage() {}
+
+func (x *ServerHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerHealthResponse.ProtoReflect.Descriptor instead.
+func (*ServerHealthResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ServerHealthResponse) GetServers() []*ServerHealthResponse_Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type ServerInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerInfoRequest) Reset() {
+	*x = ServerInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoRequest) ProtoMessage() {}
+
+func (x *ServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*ServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{73}
+}
+
+type ServerInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the raftadmin library the server was built with, or "(devel)" if unknown.
+	RaftadminVersion string `protobuf:"bytes,1,opt,name=raftadmin_version,json=raftadminVersion,proto3" json:"raftadmin_version,omitempty"`
+	// Version of github.com/hashicorp/raft the server was built with.
+	RaftVersion        string `protobuf:"bytes,2,opt,name=raft_version,json=raftVersion,proto3" json:"raft_version,omitempty"`
+	GoVersion          string `protobuf:"bytes,3,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	ProtocolVersion    int64  `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	ProtocolVersionMin int64  `protobuf:"varint,5,opt,name=protocol_version_min,json=protocolVersionMin,proto3" json:"protocol_version_min,omitempty"`
+	ProtocolVersionMax int64  `protobuf:"varint,6,opt,name=protocol_version_max,json=protocolVersionMax,proto3" json:"protocol_version_max,omitempty"`
+	SnapshotVersionMin int64  `protobuf:"varint,7,opt,name=snapshot_version_min,json=snapshotVersionMin,proto3" json:"snapshot_version_min,omitempty"`
+	SnapshotVersionMax int64  `protobuf:"varint,8,opt,name=snapshot_version_max,json=snapshotVersionMax,proto3" json:"snapshot_version_max,omitempty"`
+	// Whether the node is in maintenance mode, see EnterMaintenance.
+	Maintenance       bool   `protobuf:"varint,9,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	MaintenanceReason string `protobuf:"bytes,10,opt,name=maintenance_reason,json=maintenanceReason,proto3" json:"maintenance_reason,omitempty"`
+	// runtime.GOOS and runtime.GOARCH.
+	GoOs         string `protobuf:"bytes,11,opt,name=go_os,json=goOs,proto3" json:"go_os,omitempty"`
+	GoArch       string `protobuf:"bytes,12,opt,name=go_arch,json=goArch,proto3" json:"go_arch,omitempty"`
+	Gomaxprocs   int64  `protobuf:"varint,13,opt,name=gomaxprocs,proto3" json:"gomaxprocs,omitempty"`
+	NumGoroutine int64  `protobuf:"varint,14,opt,name=num_goroutine,json=numGoroutine,proto3" json:"num_goroutine,omitempty"`
+	// Whether RemoveServer, RestoreSnapshot and Shutdown need a confirmation_token, see raftadmin.WithConfirmation.
+	ConfirmationRequired bool `protobuf:"varint,15,opt,name=confirmation_required,json=confirmationRequired,proto3" json:"confirmation_required,omitempty"`
+}
+
+func (x *ServerInfoResponse) Reset() {
+	*x = ServerInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoResponse) ProtoMessage() {}
+
+func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*ServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ServerInfoResponse) GetRaftadminVersion() string {
+	if x != nil {
+		return x.RaftadminVersion
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetRaftVersion() string {
+	if x != nil {
+		return x.RaftVersion
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetProtocolVersion() int64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetProtocolVersionMin() int64 {
+	if x != nil {
+		return x.ProtocolVersionMin
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetProtocolVersionMax() int64 {
+	if x != nil {
+		return x.ProtocolVersionMax
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetSnapshotVersionMin() int64 {
+	if x != nil {
+		return x.SnapshotVersionMin
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetSnapshotVersionMax() int64 {
+	if x != nil {
+		return x.SnapshotVersionMax
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
+func (x *ServerInfoResponse) GetMaintenanceReason() string {
+	if x != nil {
+		return x.MaintenanceReason
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetGoOs() string {
+	if x != nil {
+		return x.GoOs
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetGoArch() string {
+	if x != nil {
+		return x.GoArch
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetGomaxprocs() int64 {
+	if x != nil {
+		return x.Gomaxprocs
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetNumGoroutine() int64 {
+	if x != nil {
+		return x.NumGoroutine
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetConfirmationRequired() bool {
+	if x != nil {
+		return x.ConfirmationRequired
+	}
+	return false
+}
+
+type SetMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Merged into the existing tags. Keys with an empty value are removed.
+	Metadata map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Remove all existing tags first.
+	Replace bool `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *SetMetadataRequest) Reset() {
+	*x = SetMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataRequest) ProtoMessage() {}
+
+func (x *SetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*SetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetMetadataRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SetMetadataRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type SetMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tags after the update.
+	Metadata map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SetMetadataResponse) Reset() {
+	*x = SetMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataResponse) ProtoMessage() {}
+
+func (x *SetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*SetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetMetadataResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ShutdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required by servers using raftadmin.WithConfirmation, see RemoveServer.
+	ConfirmationToken string `protobuf:"bytes,1,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+}
+
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShutdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownRequest) ProtoMessage() {}
+
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ShutdownRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+type SnapshotMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index              uint64                             `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Term               uint64                             `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	Size               int64                              `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Version            int64                              `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Configuration      []*GetConfigurationResponse_Server `protobuf:"bytes,6,rep,name=configuration,proto3" json:"configuration,omitempty"`
+	ConfigurationIndex uint64                             `protobuf:"varint,7,opt,name=configuration_index,json=configurationIndex,proto3" json:"configuration_index,omitempty"`
+	// When the snapshot was created, if the SnapshotStore encodes that in the ID like FileSnapshotStore does. 0 otherwise.
+	CreatedUnixNano int64 `protobuf:"varint,8,opt,name=created_unix_nano,json=createdUnixNano,proto3" json:"created_unix_nano,omitempty"`
+}
+
+func (x *SnapshotMeta) Reset() {
+	*x = SnapshotMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotMeta) ProtoMessage() {}
+
+func (x *SnapshotMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotMeta.ProtoReflect.Descriptor instead.
+func (*SnapshotMeta) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SnapshotMeta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SnapshotMeta) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SnapshotMeta) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *SnapshotMeta) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SnapshotMeta) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SnapshotMeta) GetConfiguration() []*GetConfigurationResponse_Server {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *SnapshotMeta) GetConfigurationIndex() uint64 {
+	if x != nil {
+		return x.ConfigurationIndex
+	}
+	return 0
+}
+
+func (x *SnapshotMeta) GetCreatedUnixNano() int64 {
+	if x != nil {
+		return x.CreatedUnixNano
+	}
+	return 0
+}
+
+type SnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SnapshotRequest) Reset() {
+	*x = SnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRequest) ProtoMessage() {}
+
+func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{79}
+}
+
+// StageRecoveryRequest writes a configuration that RecoverFromFile will force onto the node the next time it starts.
+type StageRecoveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*GetConfigurationResponse_Server `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Stage the recovery even though the node currently knows of a leader.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *StageRecoveryRequest) Reset() {
+	*x = StageRecoveryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageRecoveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageRecoveryRequest) ProtoMessage() {}
+
+func (x *StageRecoveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageRecoveryRequest.ProtoReflect.Descriptor instead.
+func (*StageRecoveryRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *StageRecoveryRequest) GetServers() []*GetConfigurationResponse_Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *StageRecoveryRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type StageRecoveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the file the configuration was written to.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *StageRecoveryResponse) Reset() {
+	*x = StageRecoveryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageRecoveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageRecoveryResponse) ProtoMessage() {}
+
+func (x *StageRecoveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageRecoveryResponse.ProtoReflect.Descriptor instead.
+func (*StageRecoveryResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *StageRecoveryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type StateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StateRequest) Reset() {
+	*x = StateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateRequest) ProtoMessage() {}
+
+func (x *StateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateRequest.ProtoReflect.Descriptor instead.
+func (*StateRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{82}
+}
+
+type StateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State StateResponse_State `protobuf:"varint,1,opt,name=state,proto3,enum=StateResponse_State" json:"state,omitempty"`
+}
+
+func (x *StateResponse) Reset() {
+	*x = StateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateResponse) ProtoMessage() {}
+
+func (x *StateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateResponse.ProtoReflect.Descriptor instead.
+func (*StateResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *StateResponse) GetState() StateResponse_State {
+	if x != nil {
+		return x.State
+	}
+	return StateResponse_FOLLOWER
+}
+
+type StatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{84}
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw output of raft.Stats. The other fields contain the same information, parsed.
+	Stats             map[string]string   `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	State             StateResponse_State `protobuf:"varint,2,opt,name=state,proto3,enum=StateResponse_State" json:"state,omitempty"`
+	Term              uint64              `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	LastLogIndex      uint64              `protobuf:"varint,4,opt,name=last_log_index,json=lastLogIndex,proto3" json:"last_log_index,omitempty"`
+	LastLogTerm       uint64              `protobuf:"varint,5,opt,name=last_log_term,json=lastLogTerm,proto3" json:"last_log_term,omitempty"`
+	CommitIndex       uint64              `protobuf:"varint,6,opt,name=commit_index,json=commitIndex,proto3" json:"commit_index,omitempty"`
+	AppliedIndex      uint64              `protobuf:"varint,7,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+	FsmPending        uint64              `protobuf:"varint,8,opt,name=fsm_pending,json=fsmPending,proto3" json:"fsm_pending,omitempty"`
+	LastSnapshotIndex uint64              `protobuf:"varint,9,opt,name=last_snapshot_index,json=lastSnapshotIndex,proto3" json:"last_snapshot_index,omitempty"`
+	LastSnapshotTerm  uint64              `protobuf:"varint,10,opt,name=last_snapshot_term,json=lastSnapshotTerm,proto3" json:"last_snapshot_term,omitempty"`
+	// 0 on the leader and -1 if this node never heard from a leader.
+	LastContactNanos    int64                              `protobuf:"varint,11,opt,name=last_contact_nanos,json=lastContactNanos,proto3" json:"last_contact_nanos,omitempty"`
+	LatestConfiguration []*GetConfigurationResponse_Server `protobuf:"bytes,12,rep,name=latest_configuration,json=latestConfiguration,proto3" json:"latest_configuration,omitempty"`
+	// Like GetConfigurationResponse.index; the raw stats of raft v1.5 always say 0.
+	LatestConfigurationIndex uint64 `protobuf:"varint,13,opt,name=latest_configuration_index,json=latestConfigurationIndex,proto3" json:"latest_configuration_index,omitempty"`
+	NumPeers                 uint64 `protobuf:"varint,14,opt,name=num_peers,json=numPeers,proto3" json:"num_peers,omitempty"`
+	ProtocolVersion          int64  `protobuf:"varint,15,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	ProtocolVersionMin       int64  `protobuf:"varint,16,opt,name=protocol_version_min,json=protocolVersionMin,proto3" json:"protocol_version_min,omitempty"`
+	ProtocolVersionMax       int64  `protobuf:"varint,17,opt,name=protocol_version_max,json=protocolVersionMax,proto3" json:"protocol_version_max,omitempty"`
+	SnapshotVersionMin       int64  `protobuf:"varint,18,opt,name=snapshot_version_min,json=snapshotVersionMin,proto3" json:"snapshot_version_min,omitempty"`
+	SnapshotVersionMax       int64  `protobuf:"varint,19,opt,name=snapshot_version_max,json=snapshotVersionMax,proto3" json:"snapshot_version_max,omitempty"`
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *StatsResponse) GetStats() map[string]string {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetState() StateResponse_State {
+	if x != nil {
+		return x.State
+	}
+	return StateResponse_FOLLOWER
+}
+
+func (x *StatsResponse) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetLastLogIndex() uint64 {
+	if x != nil {
+		return x.LastLogIndex
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetLastLogTerm() uint64 {
+	if x != nil {
+		return x.LastLogTerm
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetCommitIndex() uint64 {
+	if x != nil {
+		return x.CommitIndex
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAppliedIndex() uint64 {
+	if x != nil {
+		return x.AppliedIndex
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetFsmPending() uint64 {
+	if x != nil {
+		return x.FsmPending
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetLastSnapshotIndex() uint64 {
+	if x != nil {
+		return x.LastSnapshotIndex
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetLastSnapshotTerm() uint64 {
+	if x != nil {
+		return x.LastSnapshotTerm
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetLastContactNanos() int64 {
+	if x != nil {
+		return x.LastContactNanos
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetLatestConfiguration() []*GetConfigurationResponse_Server {
+	if x != nil {
+		return x.LatestConfiguration
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetLatestConfigurationIndex() uint64 {
+	if x != nil {
+		return x.LatestConfigurationIndex
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetNumPeers() uint64 {
+	if x != nil {
+		return x.NumPeers
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetProtocolVersion() int64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetProtocolVersionMin() int64 {
+	if x != nil {
+		return x.ProtocolVersionMin
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetProtocolVersionMax() int64 {
+	if x != nil {
+		return x.ProtocolVersionMax
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetSnapshotVersionMin() int64 {
+	if x != nil {
+		return x.SnapshotVersionMin
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetSnapshotVersionMax() int64 {
+	if x != nil {
+		return x.SnapshotVersionMax
+	}
+	return 0
+}
+
+type StatsHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return samples taken in this range. 0 means unbounded.
+	SinceUnixNano int64 `protobuf:"varint,1,opt,name=since_unix_nano,json=sinceUnixNano,proto3" json:"since_unix_nano,omitempty"`
+	UntilUnixNano int64 `protobuf:"varint,2,opt,name=until_unix_nano,json=untilUnixNano,proto3" json:"until_unix_nano,omitempty"`
+}
+
+func (x *StatsHistoryRequest) Reset() {
+	*x = StatsHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsHistoryRequest) ProtoMessage() {}
+
+func (x *StatsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*StatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StatsHistoryRequest) GetSinceUnixNano() int64 {
+	if x != nil {
+		return x.SinceUnixNano
+	}
+	return 0
+}
+
+func (x *StatsHistoryRequest) GetUntilUnixNano() int64 {
+	if x != nil {
+		return x.UntilUnixNano
+	}
+	return 0
+}
+
+type StatsHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalNanos int64 `protobuf:"varint,1,opt,name=interval_nanos,json=intervalNanos,proto3" json:"interval_nanos,omitempty"`
+	// Oldest first.
+	Samples []*StatsHistoryResponse_Sample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *StatsHistoryResponse) Reset() {
+	*x = StatsHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsHistoryResponse) ProtoMessage() {}
+
+func (x *StatsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*StatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *StatsHistoryResponse) GetIntervalNanos() int64 {
+	if x != nil {
+		return x.IntervalNanos
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse) GetSamples() []*StatsHistoryResponse_Sample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type StepDownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long to wait for the leadership transfer. 0 means until raft gives up on it.
+	TimeoutMs uint64 `protobuf:"varint,1,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *StepDownRequest) Reset() {
+	*x = StepDownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepDownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepDownRequest) ProtoMessage() {}
+
+func (x *StepDownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepDownRequest.ProtoReflect.Descriptor instead.
+func (*StepDownRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *StepDownRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type StepDownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new leader, if this node already knows it.
+	NewLeader *LeaderResponse `protobuf:"bytes,1,opt,name=new_leader,json=newLeader,proto3" json:"new_leader,omitempty"`
+}
+
+func (x *StepDownResponse) Reset() {
+	*x = StepDownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepDownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepDownResponse) ProtoMessage() {}
+
+func (x *StepDownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepDownResponse.ProtoReflect.Descriptor instead.
+func (*StepDownResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *StepDownResponse) GetNewLeader() *LeaderResponse {
+	if x != nil {
+		return x.NewLeader
+	}
+	return nil
+}
+
+type VerifyFSMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, wait until this index is applied before computing the checksum.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// How long to wait for index. 0 means until the RPC's deadline.
+	TimeoutMs uint64 `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *VerifyFSMRequest) Reset() {
+	*x = VerifyFSMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyFSMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyFSMRequest) ProtoMessage() {}
+
+func (x *VerifyFSMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyFSMRequest.ProtoReflect.Descriptor instead.
+func (*VerifyFSMRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *VerifyFSMRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *VerifyFSMRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type VerifyFSMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checksum     uint64 `protobuf:"varint,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	AppliedIndex uint64 `protobuf:"varint,2,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+}
+
+func (x *VerifyFSMResponse) Reset() {
+	*x = VerifyFSMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyFSMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyFSMResponse) ProtoMessage() {}
+
+func (x *VerifyFSMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyFSMResponse.ProtoReflect.Descriptor instead.
+func (*VerifyFSMResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *VerifyFSMResponse) GetChecksum() uint64 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
+func (x *VerifyFSMResponse) GetAppliedIndex() uint64 {
+	if x != nil {
+		return x.AppliedIndex
+	}
+	return 0
+}
+
+type VerifyLeaderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyLeaderRequest) Reset() {
+	*x = VerifyLeaderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyLeaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLeaderRequest) ProtoMessage() {}
+
+func (x *VerifyLeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLeaderRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLeaderRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{92}
+}
+
+type WaitForIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// How long to wait before giving up with DEADLINE_EXCEEDED. 0 means the deadline of the RPC, if any.
+	TimeoutMs uint64 `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *WaitForIndexRequest) Reset() {
+	*x = WaitForIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForIndexRequest) ProtoMessage() {}
+
+func (x *WaitForIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForIndexRequest.ProtoReflect.Descriptor instead.
+func (*WaitForIndexRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *WaitForIndexRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *WaitForIndexRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type WaitForIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppliedIndex uint64 `protobuf:"varint,1,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+}
+
+func (x *WaitForIndexResponse) Reset() {
+	*x = WaitForIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForIndexResponse) ProtoMessage() {}
+
+func (x *WaitForIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForIndexResponse.ProtoReflect.Descriptor instead.
+func (*WaitForIndexResponse) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *WaitForIndexResponse) GetAppliedIndex() uint64 {
+	if x != nil {
+		return x.AppliedIndex
+	}
+	return 0
+}
+
+type WatchAppliedIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Send at most one update per this many milliseconds. 0 means every change, which the server checks for every 10ms.
+	MinIntervalMs uint64 `protobuf:"varint,1,opt,name=min_interval_ms,json=minIntervalMs,proto3" json:"min_interval_ms,omitempty"`
+}
+
+func (x *WatchAppliedIndexRequest) Reset() {
+	*x = WatchAppliedIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchAppliedIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAppliedIndexRequest) ProtoMessage() {}
+
+func (x *WatchAppliedIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAppliedIndexRequest.ProtoReflect.Descriptor instead.
+func (*WatchAppliedIndexRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *WatchAppliedIndexRequest) GetMinIntervalMs() uint64 {
+	if x != nil {
+		return x.MinIntervalMs
+	}
+	return 0
+}
+
+type WatchConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchConfigurationRequest) Reset() {
+	*x = WatchConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchConfigurationRequest) ProtoMessage() {}
+
+func (x *WatchConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*WatchConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{96}
+}
+
+type WatchLeaderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchLeaderRequest) Reset() {
+	*x = WatchLeaderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchLeaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLeaderRequest) ProtoMessage() {}
+
+func (x *WatchLeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLeaderRequest.ProtoReflect.Descriptor instead.
+func (*WatchLeaderRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{97}
+}
+
+type WatchStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchStateRequest) Reset() {
+	*x = WatchStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStateRequest) ProtoMessage() {}
+
+func (x *WatchStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStateRequest.ProtoReflect.Descriptor instead.
+func (*WatchStateRequest) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{98}
+}
+
+type GetConfigurationResponse_Server struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suffrage GetConfigurationResponse_Server_Suffrage `protobuf:"varint,1,opt,name=suffrage,proto3,enum=GetConfigurationResponse_Server_Suffrage" json:"suffrage,omitempty"`
+	Id       string                                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Address  string                                   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// The tags of the server, see SetMetadata. Those of other nodes are only included if the server has raftadmin.WithPeerDialOptions and can reach them. WatchConfiguration doesn't fill this in.
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetConfigurationResponse_Server) Reset() {
+	*x = GetConfigurationResponse_Server{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigurationResponse_Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationResponse_Server) ProtoMessage() {}
+
+func (x *GetConfigurationResponse_Server) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationResponse_Server.ProtoReflect.Descriptor instead.
+func (*GetConfigurationResponse_Server) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *GetConfigurationResponse_Server) GetSuffrage() GetConfigurationResponse_Server_Suffrage {
+	if x != nil {
+		return x.Suffrage
+	}
+	return GetConfigurationResponse_Server_VOTER
+}
+
+func (x *GetConfigurationResponse_Server) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetConfigurationResponse_Server) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetConfigurationResponse_Server) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetLogsResponse_Log struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index              uint64                   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Term               uint64                   `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	Type               GetLogsResponse_Log_Type `protobuf:"varint,3,opt,name=type,proto3,enum=GetLogsResponse_Log_Type" json:"type,omitempty"`
+	DataLength         uint64                   `protobuf:"varint,4,opt,name=data_length,json=dataLength,proto3" json:"data_length,omitempty"`
+	Data               []byte                   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Extensions         []byte                   `protobuf:"bytes,6,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	AppendedAtUnixNano int64                    `protobuf:"varint,7,opt,name=appended_at_unix_nano,json=appendedAtUnixNano,proto3" json:"appended_at_unix_nano,omitempty"`
+}
+
+func (x *GetLogsResponse_Log) Reset() {
+	*x = GetLogsResponse_Log{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsResponse_Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsResponse_Log) ProtoMessage() {}
+
+func (x *GetLogsResponse_Log) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsResponse_Log.ProtoReflect.Descriptor instead.
+func (*GetLogsResponse_Log) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *GetLogsResponse_Log) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetLogsResponse_Log) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *GetLogsResponse_Log) GetType() GetLogsResponse_Log_Type {
+	if x != nil {
+		return x.Type
+	}
+	return GetLogsResponse_Log_COMMAND
+}
+
+func (x *GetLogsResponse_Log) GetDataLength() uint64 {
+	if x != nil {
+		return x.DataLength
+	}
+	return 0
+}
+
+func (x *GetLogsResponse_Log) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetLogsResponse_Log) GetExtensions() []byte {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *GetLogsResponse_Log) GetAppendedAtUnixNano() int64 {
+	if x != nil {
+		return x.AppendedAtUnixNano
+	}
+	return 0
+}
+
+type ListClustersResponse_Cluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The cluster ID to send in the raftadmin-cluster metadata.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The state of this node in the group.
+	State  StateResponse_State `protobuf:"varint,2,opt,name=state,proto3,enum=StateResponse_State" json:"state,omitempty"`
+	Leader *LeaderResponse     `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	Voters int64               `protobuf:"varint,4,opt,name=voters,proto3" json:"voters,omitempty"`
+	// Including staging servers.
+	Nonvoters int64 `protobuf:"varint,5,opt,name=nonvoters,proto3" json:"nonvoters,omitempty"`
+}
+
+func (x *ListClustersResponse_Cluster) Reset() {
+	*x = ListClustersResponse_Cluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClustersResponse_Cluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersResponse_Cluster) ProtoMessage() {}
+
+func (x *ListClustersResponse_Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersResponse_Cluster.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse_Cluster) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *ListClustersResponse_Cluster) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListClustersResponse_Cluster) GetState() StateResponse_State {
+	if x != nil {
+		return x.State
+	}
+	return StateResponse_FOLLOWER
+}
+
+func (x *ListClustersResponse_Cluster) GetLeader() *LeaderResponse {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
+func (x *ListClustersResponse_Cluster) GetVoters() int64 {
+	if x != nil {
+		return x.Voters
+	}
+	return 0
+}
+
+func (x *ListClustersResponse_Cluster) GetNonvoters() int64 {
+	if x != nil {
+		return x.Nonvoters
+	}
+	return 0
+}
+
+type ListOperationsResponse_Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartUnixNano int64  `protobuf:"varint,1,opt,name=start_unix_nano,json=startUnixNano,proto3" json:"start_unix_nano,omitempty"`
+	Method        string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// The request in text format, truncated. "(stream)" for streaming RPCs. Bytes fields, like the data of ApplyLog, are replaced by their length, like "(12 bytes)".
+	Request string `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// The common name of the caller's TLS client certificate, if any, and their address.
+	Caller string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	// The gRPC status code, like "OK".
+	Code          string `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	DurationNanos int64  `protobuf:"varint,7,opt,name=duration_nanos,json=durationNanos,proto3" json:"duration_nanos,omitempty"`
+}
+
+func (x *ListOperationsResponse_Operation) Reset() {
+	*x = ListOperationsResponse_Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsResponse_Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse_Operation) ProtoMessage() {}
+
+func (x *ListOperationsResponse_Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse_Operation.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse_Operation) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *ListOperationsResponse_Operation) GetStartUnixNano() int64 {
+	if x != nil {
+		return x.StartUnixNano
+	}
+	return 0
+}
+
+func (x *ListOperationsResponse_Operation) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListOperationsResponse_Operation) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *ListOperationsResponse_Operation) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *ListOperationsResponse_Operation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListOperationsResponse_Operation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ListOperationsResponse_Operation) GetDurationNanos() int64 {
+	if x != nil {
+		return x.DurationNanos
+	}
+	return 0
+}
+
+type ListPendingFuturesResponse_PendingFuture struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for operations started by another caller; see Future.
+	OperationToken string `protobuf:"bytes,1,opt,name=operation_token,json=operationToken,proto3" json:"operation_token,omitempty"`
+	// The name of the RPC that started the operation, like "AddVoter".
+	Operation       string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	CreatedUnixNano int64  `protobuf:"varint,3,opt,name=created_unix_nano,json=createdUnixNano,proto3" json:"created_unix_nano,omitempty"`
+	// The number of Await calls currently waiting for the operation.
+	Awaiting int64 `protobuf:"varint,4,opt,name=awaiting,proto3" json:"awaiting,omitempty"`
+	// Whether the operation finished, so Await returns its result right away. Futures that are completed but still listed were never Forgotten.
+	Completed bool `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+}
+
+func (x *ListPendingFuturesResponse_PendingFuture) Reset() {
+	*x = ListPendingFuturesResponse_PendingFuture{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingFuturesResponse_PendingFuture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingFuturesResponse_PendingFuture) ProtoMessage() {}
+
+func (x *ListPendingFuturesResponse_PendingFuture) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingFuturesResponse_PendingFuture.ProtoReflect.Descriptor instead.
+func (*ListPendingFuturesResponse_PendingFuture) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *ListPendingFuturesResponse_PendingFuture) GetOperationToken() string {
+	if x != nil {
+		return x.OperationToken
+	}
+	return ""
+}
+
+func (x *ListPendingFuturesResponse_PendingFuture) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListPendingFuturesResponse_PendingFuture) GetCreatedUnixNano() int64 {
+	if x != nil {
+		return x.CreatedUnixNano
+	}
+	return 0
+}
+
+func (x *ListPendingFuturesResponse_PendingFuture) GetAwaiting() int64 {
+	if x != nil {
+		return x.Awaiting
+	}
+	return 0
+}
+
+func (x *ListPendingFuturesResponse_PendingFuture) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type LogStoreStatsResponse_Storage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SizeBytes          int64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FreePages          int64 `protobuf:"varint,2,opt,name=free_pages,json=freePages,proto3" json:"free_pages,omitempty"`
+	PendingPages       int64 `protobuf:"varint,3,opt,name=pending_pages,json=pendingPages,proto3" json:"pending_pages,omitempty"`
+	FreeAllocBytes     int64 `protobuf:"varint,4,opt,name=free_alloc_bytes,json=freeAllocBytes,proto3" json:"free_alloc_bytes,omitempty"`
+	FreelistInuseBytes int64 `protobuf:"varint,5,opt,name=freelist_inuse_bytes,json=freelistInuseBytes,proto3" json:"freelist_inuse_bytes,omitempty"`
+}
+
+func (x *LogStoreStatsResponse_Storage) Reset() {
+	*x = LogStoreStatsResponse_Storage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStoreStatsResponse_Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStoreStatsResponse_Storage) ProtoMessage() {}
+
+func (x *LogStoreStatsResponse_Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStoreStatsResponse_Storage.ProtoReflect.Descriptor instead.
+func (*LogStoreStatsResponse_Storage) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *LogStoreStatsResponse_Storage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *LogStoreStatsResponse_Storage) GetFreePages() int64 {
+	if x != nil {
+		return x.FreePages
+	}
+	return 0
+}
+
+func (x *LogStoreStatsResponse_Storage) GetPendingPages() int64 {
+	if x != nil {
+		return x.PendingPages
+	}
+	return 0
+}
+
+func (x *LogStoreStatsResponse_Storage) GetFreeAllocBytes() int64 {
+	if x != nil {
+		return x.FreeAllocBytes
+	}
+	return 0
+}
+
+func (x *LogStoreStatsResponse_Storage) GetFreelistInuseBytes() int64 {
+	if x != nil {
+		return x.FreelistInuseBytes
+	}
+	return 0
+}
+
+type Observation_Leader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Observation_Leader) Reset() {
+	*x = Observation_Leader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Observation_Leader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation_Leader) ProtoMessage() {}
+
+func (x *Observation_Leader) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation_Leader.ProtoReflect.Descriptor instead.
+func (*Observation_Leader) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *Observation_Leader) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Observation_Leader) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Observation_Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed  bool                                     `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	Id       string                                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Address  string                                   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Suffrage GetConfigurationResponse_Server_Suffrage `protobuf:"varint,4,opt,name=suffrage,proto3,enum=GetConfigurationResponse_Server_Suffrage" json:"suffrage,omitempty"`
+}
+
+func (x *Observation_Peer) Reset() {
+	*x = Observation_Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Observation_Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation_Peer) ProtoMessage() {}
+
+func (x *Observation_Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation_Peer.ProtoReflect.Descriptor instead.
+func (*Observation_Peer) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{58, 1}
+}
+
+func (x *Observation_Peer) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *Observation_Peer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Observation_Peer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Observation_Peer) GetSuffrage() GetConfigurationResponse_Server_Suffrage {
+	if x != nil {
+		return x.Suffrage
+	}
+	return GetConfigurationResponse_Server_VOTER
+}
+
+type Observation_FailedHeartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LastContactUnixNano int64  `protobuf:"varint,2,opt,name=last_contact_unix_nano,json=lastContactUnixNano,proto3" json:"last_contact_unix_nano,omitempty"`
+}
+
+func (x *Observation_FailedHeartbeat) Reset() {
+	*x = Observation_FailedHeartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Observation_FailedHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation_FailedHeartbeat) ProtoMessage() {}
+
+func (x *Observation_FailedHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation_FailedHeartbeat.ProtoReflect.Descriptor instead.
+func (*Observation_FailedHeartbeat) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{58, 2}
+}
+
+func (x *Observation_FailedHeartbeat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Observation_FailedHeartbeat) GetLastContactUnixNano() int64 {
+	if x != nil {
+		return x.LastContactUnixNano
+	}
+	return 0
+}
+
+type Observation_ResumedHeartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Observation_ResumedHeartbeat) Reset() {
+	*x = Observation_ResumedHeartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Observation_ResumedHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation_ResumedHeartbeat) ProtoMessage() {}
+
+func (x *Observation_ResumedHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation_ResumedHeartbeat.ProtoReflect.Descriptor instead.
+func (*Observation_ResumedHeartbeat) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{58, 3}
+}
+
+func (x *Observation_ResumedHeartbeat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RequestVote is sent when this node receives a vote request from a candidate.
+type Observation_RequestVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CandidateId        string `protobuf:"bytes,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	CandidateAddress   string `protobuf:"bytes,2,opt,name=candidate_address,json=candidateAddress,proto3" json:"candidate_address,omitempty"`
+	Term               uint64 `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	LastLogIndex       uint64 `protobuf:"varint,4,opt,name=last_log_index,json=lastLogIndex,proto3" json:"last_log_index,omitempty"`
+	LastLogTerm        uint64 `protobuf:"varint,5,opt,name=last_log_term,json=lastLogTerm,proto3" json:"last_log_term,omitempty"`
+	LeadershipTransfer bool   `protobuf:"varint,6,opt,name=leadership_transfer,json=leadershipTransfer,proto3" json:"leadership_transfer,omitempty"`
+}
+
+func (x *Observation_RequestVote) Reset() {
+	*x = Observation_RequestVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Observation_RequestVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation_RequestVote) ProtoMessage() {}
+
+func (x *Observation_RequestVote) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation_RequestVote.ProtoReflect.Descriptor instead.
+func (*Observation_RequestVote) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{58, 4}
+}
+
+func (x *Observation_RequestVote) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *Observation_RequestVote) GetCandidateAddress() string {
+	if x != nil {
+		return x.CandidateAddress
+	}
+	return ""
+}
+
+func (x *Observation_RequestVote) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *Observation_RequestVote) GetLastLogIndex() uint64 {
+	if x != nil {
+		return x.LastLogIndex
+	}
+	return 0
+}
+
+func (x *Observation_RequestVote) GetLastLogTerm() uint64 {
+	if x != nil {
+		return x.LastLogTerm
+	}
+	return 0
+}
+
+func (x *Observation_RequestVote) GetLeadershipTransfer() bool {
+	if x != nil {
+		return x.LeadershipTransfer
+	}
+	return false
+}
+
+type PeerLastContactResponse_Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether the leader's heartbeats to this peer are currently failing.
+	HeartbeatFailing bool `protobuf:"varint,3,opt,name=heartbeat_failing,json=heartbeatFailing,proto3" json:"heartbeat_failing,omitempty"`
+	// When the leader last heard from the peer. For peers with failing heartbeats, this is what raft reported when the heartbeats started failing.
+	// For others, it's when the peer says it last heard from the leader, which requires raftadmin.WithPeerDialOptions.
+	// 0 if it's unknown: the peer can't be asked or doesn't answer within a second.
+	LastContactUnixNano int64 `protobuf:"varint,4,opt,name=last_contact_unix_nano,json=lastContactUnixNano,proto3" json:"last_contact_unix_nano,omitempty"`
+}
+
+func (x *PeerLastContactResponse_Peer) Reset() {
+	*x = PeerLastContactResponse_Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerLastContactResponse_Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerLastContactResponse_Peer) ProtoMessage() {}
+
+func (x *PeerLastContactResponse_Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerLastContactResponse_Peer.ProtoReflect.Descriptor instead.
+func (*PeerLastContactResponse_Peer) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *PeerLastContactResponse_Peer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PeerLastContactResponse_Peer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerLastContactResponse_Peer) GetHeartbeatFailing() bool {
+	if x != nil {
+		return x.HeartbeatFailing
+	}
+	return false
+}
+
+func (x *PeerLastContactResponse_Peer) GetLastContactUnixNano() int64 {
+	if x != nil {
+		return x.LastContactUnixNano
+	}
+	return 0
+}
+
+type ReplicationStatusResponse_Follower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address  string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Suffrage GetConfigurationResponse_Server_Suffrage `protobuf:"varint,3,opt,name=suffrage,proto3,enum=GetConfigurationResponse_Server_Suffrage" json:"suffrage,omitempty"`
+	// raft doesn't expose the leader's match index, so this is the last index in the follower's log, as reported by the follower itself.
+	MatchIndex uint64 `protobuf:"varint,4,opt,name=match_index,json=matchIndex,proto3" json:"match_index,omitempty"`
+	// How far match_index is behind the leader's last index.
+	Lag uint64 `protobuf:"varint,5,opt,name=lag,proto3" json:"lag,omitempty"`
+	// Time since the follower last heard from the leader, as reported by the follower. -1 if it never did.
+	LastContactNanos int64 `protobuf:"varint,6,opt,name=last_contact_nanos,json=lastContactNanos,proto3" json:"last_contact_nanos,omitempty"`
+	// Set if the follower couldn't be reached. The other fields are then unset.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ReplicationStatusResponse_Follower) Reset() {
+	*x = ReplicationStatusResponse_Follower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicationStatusResponse_Follower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationStatusResponse_Follower) ProtoMessage() {}
+
+func (x *ReplicationStatusResponse_Follower) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationStatusResponse_Follower.ProtoReflect.Descriptor instead.
+func (*ReplicationStatusResponse_Follower) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *ReplicationStatusResponse_Follower) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplicationStatusResponse_Follower) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReplicationStatusResponse_Follower) GetSuffrage() GetConfigurationResponse_Server_Suffrage {
+	if x != nil {
+		return x.Suffrage
+	}
+	return GetConfigurationResponse_Server_VOTER
+}
+
+func (x *ReplicationStatusResponse_Follower) GetMatchIndex() uint64 {
+	if x != nil {
+		return x.MatchIndex
+	}
+	return 0
+}
+
+func (x *ReplicationStatusResponse_Follower) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *ReplicationStatusResponse_Follower) GetLastContactNanos() int64 {
+	if x != nil {
+		return x.LastContactNanos
+	}
+	return 0
+}
+
+func (x *ReplicationStatusResponse_Follower) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ServerHealthResponse_Server struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address  string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Suffrage GetConfigurationResponse_Server_Suffrage `protobuf:"varint,3,opt,name=suffrage,proto3,enum=GetConfigurationResponse_Server_Suffrage" json:"suffrage,omitempty"`
+	Leader   bool                                     `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	// Whether the leader's heartbeats to the server are succeeding. Always true for the leader itself.
+	Alive bool `protobuf:"varint,5,opt,name=alive,proto3" json:"alive,omitempty"`
+	// Since when the server has been alive without interruption, as far as the current leader knows. 0 if it doesn't know since when, like when the server was created after it became leader.
+	StableSinceUnixNano int64 `protobuf:"varint,6,opt,name=stable_since_unix_nano,json=stableSinceUnixNano,proto3" json:"stable_since_unix_nano,omitempty"`
+	// How far the server's log is behind the leader's. Only measured with raftadmin.WithPeerDialOptions.
+	Lag uint64 `protobuf:"varint,7,opt,name=lag,proto3" json:"lag,omitempty"`
+	// Whether the server is alive, stable for long enough and not lagging too far behind.
+	Healthy bool `protobuf:"varint,8,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Why the server isn't healthy.
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ServerHealthResponse_Server) Reset() {
+	*x = ServerHealthResponse_Server{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerHealthResponse_Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerHealthResponse_Server) ProtoMessage() {}
+
+func (x *ServerHealthResponse_Server) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerHealthResponse_Server.ProtoReflect.Descriptor instead.
+func (*ServerHealthResponse_Server) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *ServerHealthResponse_Server) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServerHealthResponse_Server) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ServerHealthResponse_Server) GetSuffrage() GetConfigurationResponse_Server_Suffrage {
+	if x != nil {
+		return x.Suffrage
+	}
+	return GetConfigurationResponse_Server_VOTER
+}
+
+func (x *ServerHealthResponse_Server) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+func (x *ServerHealthResponse_Server) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *ServerHealthResponse_Server) GetStableSinceUnixNano() int64 {
+	if x != nil {
+		return x.StableSinceUnixNano
+	}
+	return 0
+}
+
+func (x *ServerHealthResponse_Server) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *ServerHealthResponse_Server) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ServerHealthResponse_Server) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StatsHistoryResponse_Sample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeUnixNano      int64               `protobuf:"varint,1,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	State             StateResponse_State `protobuf:"varint,2,opt,name=state,proto3,enum=StateResponse_State" json:"state,omitempty"`
+	Term              uint64              `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	LeaderId          string              `protobuf:"bytes,4,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	LastLogIndex      uint64              `protobuf:"varint,5,opt,name=last_log_index,json=lastLogIndex,proto3" json:"last_log_index,omitempty"`
+	CommitIndex       uint64              `protobuf:"varint,6,opt,name=commit_index,json=commitIndex,proto3" json:"commit_index,omitempty"`
+	AppliedIndex      uint64              `protobuf:"varint,7,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+	FsmPending        uint64              `protobuf:"varint,8,opt,name=fsm_pending,json=fsmPending,proto3" json:"fsm_pending,omitempty"`
+	LastSnapshotIndex uint64              `protobuf:"varint,9,opt,name=last_snapshot_index,json=lastSnapshotIndex,proto3" json:"last_snapshot_index,omitempty"`
+	// 0 on the leader and -1 if this node never heard from a leader.
+	LastContactNanos int64 `protobuf:"varint,10,opt,name=last_contact_nanos,json=lastContactNanos,proto3" json:"last_contact_nanos,omitempty"`
+}
+
+func (x *StatsHistoryResponse_Sample) Reset() {
+	*x = StatsHistoryResponse_Sample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raftadmin_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsHistoryResponse_Sample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsHistoryResponse_Sample) ProtoMessage() {}
+
+func (x *StatsHistoryResponse_Sample) ProtoReflect() protoreflect.Message {
+	mi := &file_raftadmin_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsHistoryResponse_Sample.ProtoReflect.Descriptor instead.
+func (*StatsHistoryResponse_Sample) Descriptor() ([]byte, []int) {
+	return file_raftadmin_proto_rawDescGZIP(), []int{87, 0}
+}
+
+func (x *StatsHistoryResponse_Sample) GetTimeUnixNano() int64 {
+	if x != nil {
+		return x.TimeUnixNano
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse_Sample) GetState() StateResponse_State {
+	if x != nil {
+		return x.State
+	}
+	return StateResponse_FOLLOWER
+}
+
+func (x *StatsHistoryResponse_Sample) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse_Sample) GetLeaderId() string {
+	if x != nil {
+		return x.LeaderId
+	}
+	return ""
+}
+
+func (x *StatsHistoryResponse_Sample) GetLastLogIndex() uint64 {
+	if x != nil {
+		return x.LastLogIndex
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse_Sample) GetCommitIndex() uint64 {
+	if x != nil {
+		return x.CommitIndex
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse_Sample) GetAppliedIndex() uint64 {
+	if x != nil {
+		return x.AppliedIndex
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse_Sample) GetFsmPending() uint64 {
+	if x != nil {
+		return x.FsmPending
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse_Sample) GetLastSnapshotIndex() uint64 {
+	if x != nil {
+		return x.LastSnapshotIndex
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse_Sample) GetLastContactNanos() int64 {
+	if x != nil {
+		return x.LastContactNanos
+	}
+	return 0
+}
+
+var File_raftadmin_proto protoreflect.FileDescriptor
+
+var file_raftadmin_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x72, 0x61, 0x66, 0x74, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x31, 0x0a, 0x06, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x0e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x64, 0x0a, 0x0d, 0x41, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x10, 0x0a, 0x0e, 0x46, 0x6f, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x6e,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x3f, 0x0a, 0x11,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3e, 0x0a,
+	0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x64, 0x0a,
+	0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x14, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2f, 0x0a, 0x0e, 0x42, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x55, 0x0a, 0x17, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x22, 0x14, 0x0a, 0x12, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x80, 0x01,
+	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xa1, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x56,
+	0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x7a, 0x0a, 0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x31,
+	0x0a, 0x17, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a,
+	0x16, 0x45, 0x78, 0x69, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x45, 0x78, 0x69, 0x74, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa3, 0x03,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0xb4, 0x02, 0x0a,
+	0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x66, 0x66,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x30, 0x0a, 0x08, 0x53, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x12, 0x09, 0x0a,
+	0x05, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x4e, 0x56,
+	0x4f, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x47, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54, 0x65,
+	0x72, 0x6d, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xb7, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x52,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x1a, 0xda, 0x02, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x61, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x72, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x44, 0x44, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f,
+	0x50, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x41, 0x52, 0x52, 0x49, 0x45, 0x52, 0x10, 0x04, 0x12, 0x11,
+	0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x05, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1c, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x72,
+	0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x14, 0x0a, 0x12,
+	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69,
+	0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e,
+	0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x11, 0x4c, 0x61,
+	0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa5, 0x01, 0x0a,
+	0x14, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x67, 0x65, 0x4e,
+	0x61, 0x6e, 0x6f, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d,
+	0x0a, 0x21, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x1a, 0xa4, 0x01, 0x0a, 0x07, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x45, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xce, 0x01, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x07, 0x66,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0xbc, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f,
+	0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61,
+	0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x15,
+	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a,
+	0xc8, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
+	0x65, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x66, 0x72, 0x65, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x72, 0x65, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x75, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x66, 0x72, 0x65, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x75, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x50, 0x45, 0x45, 0x52, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a,
+	0x11, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x44, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45,
+	0x41, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+	0x56, 0x4f, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10,
+	0x05, 0x22, 0xca, 0x07, 0x0a, 0x0b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x2d,
+	0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00,
+	0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x12, 0x4c, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12,
+	0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x32, 0x0a, 0x06,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x1a, 0x91, 0x01, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a,
+	0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66,
+	0x72, 0x61, 0x67, 0x65, 0x1a, 0x56, 0x0a, 0x0f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x1a, 0x22, 0x0a, 0x10,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x1a, 0xec, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x74, 0x65, 0x72, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x2f,
+	0x0a, 0x13, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42,
+	0x0d, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18,
+	0x0a, 0x16, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x17, 0x50, 0x65, 0x65,
+	0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x92, 0x01, 0x0a, 0x04, 0x50, 0x65,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x8c,
+	0x02, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6e, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x36, 0x0a, 0x17, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x15, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x61, 0x6e, 0x6f,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x15, 0x0a,
+	0x13, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x40, 0x0a,
+	0x13, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x41, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0xca, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22,
+	0x1a, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x95, 0x03, 0x0a, 0x19,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x41, 0x0a, 0x09, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x1a, 0xf2,
+	0x01, 0x0a, 0x08, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12,
+	0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f,
+	0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x19, 0x0a,
+	0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x73, 0x22, 0xf1, 0x02, 0x0a,
+	0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x1a, 0xa0, 0x02,
+	0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xef, 0x04, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x72, 0x61, 0x66, 0x74, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x61, 0x66, 0x74, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x66,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x61, 0x66, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x14,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x13, 0x0a, 0x05, 0x67, 0x6f, 0x5f, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x67, 0x6f, 0x4f, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x41, 0x72, 0x63, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x0f, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x0c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a,
+	0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61,
+	0x6e, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x14,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x3e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4f, 0x4c,
+	0x4c, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x44, 0x49,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52,
+	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03,
+	0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x9c, 0x07, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x73, 0x6d, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x73, 0x6d, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x54, 0x65, 0x72, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6e,
+	0x6f, 0x73, 0x12, 0x53, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x13, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1a, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12,
+	0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
+	0x78, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x65, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f,
+	0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x26,
+	0x0a, 0x0f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x55, 0x6e,
+	0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0xf0, 0x03, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6e, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x1a, 0xf8,
+	0x02, 0x0a, 0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12,
+	0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x73,
+	0x6d, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x66, 0x73, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6e, 0x6f,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x30, 0x0a, 0x0f, 0x53, 0x74, 0x65,
+	0x70, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x53,
+	0x74, 0x65, 0x70, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
+	0x47, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x53, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x54, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x46, 0x53, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x15,
+	0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x22, 0x3b, 0x0a, 0x14, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x42,
+	0x0a, 0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x14, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x89, 0x1c, 0x0a, 0x09, 0x52,
+	0x61, 0x66, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x35, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4e,
+	0x6f, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x6e,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x41, 0x64,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x14, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x4c, 0x6f, 0x67, 0x12, 0x10, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x41, 0x77, 0x61,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x2d, 0x0a, 0x07, 0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x42, 0x61,
+	0x72, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x13, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
+	0x6f, 0x67, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x35, 0x0a, 0x0b, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x13, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x45, 0x6e, 0x74, 0x65,
+	0x72, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x45,
+	0x78, 0x69, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1b, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x2e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c,
+	0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x12, 0x13, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x11, 0x2e, 0x4c, 0x61,
+	0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x1a, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x15, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x6f, 0x67, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x07, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x0f,
+	0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x46, 0x0a, 0x0f, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x51, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x51, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x14, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x13, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x08,
+	0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x10, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x75, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a,
+	0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x10, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x15, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x28, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x74, 0x65, 0x70, 0x44, 0x6f, 0x77, 0x6e, 0x12,
+	0x10, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x46, 0x53, 0x4d, 0x12, 0x11, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x53, 0x4d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46,
+	0x53, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x57, 0x61,
+	0x69, 0x74, 0x46, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x4f, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x37, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x13, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x22, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12, 0x07, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72,
+	0x65, 0x1a, 0x0e, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x07, 0x2e,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x0f, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x77, 0x61,
+	0x69, 0x74, 0x41, 0x6e, 0x64, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x07, 0x2e, 0x46, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x1a, 0x0e, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa6, 0x0f, 0x0a, 0x0b, 0x52, 0x61, 0x66, 0x74, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x13, 0x2e, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x11, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c,
+	0x4c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x2e, 0x4c,
+	0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x15,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x15, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2c, 0x0a, 0x07, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x0f, 0x2e, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x46, 0x0a, 0x0f, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x17, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x14, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x0d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x14, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x53, 0x4d, 0x12, 0x11, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x53, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x53, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x57, 0x61,
+	0x69, 0x74, 0x46, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x4f, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x37, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x13, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42,
+	0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4a, 0x69,
+	0x6c, 0x6c, 0x65, 0x2f, 0x72, 0x61, 0x66, 0x74, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_raftadmin_proto_rawDescOnce sync.Once
+	file_raftadmin_proto_rawDescData = file_raftadmin_proto_rawDesc
+)
+
+func file_raftadmin_proto_rawDescGZIP() []byte {
+	file_raftadmin_proto_rawDescOnce.Do(func() {
+		file_raftadmin_proto_rawDescData = protoimpl.X.CompressGZIP(file_raftadmin_proto_rawDescData)
+	})
+	return file_raftadmin_proto_rawDescData
+}
+
+var file_raftadmin_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_raftadmin_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
+var file_raftadmin_proto_goTypes = []interface{}{
+	(GetConfigurationResponse_Server_Suffrage)(0),    // 0: GetConfigurationResponse.Server.Suffrage
+	(GetLogsResponse_Log_Type)(0),                    // 1: GetLogsResponse.Log.Type
+	(ObserveRequest_Type)(0),                         // 2: ObserveRequest.Type
+	(StateResponse_State)(0),                         // 3: StateResponse.State
+	(*Future)(nil),                                   // 4: Future
+	(*FutureResponse)(nil),                           // 5: FutureResponse
+	(*AwaitResponse)(nil),                            // 6: AwaitResponse
+	(*ForgetResponse)(nil),                           // 7: ForgetResponse
+	(*AddVoterRequest)(nil),                          // 8: AddVoterRequest
+	(*AddNonvoterRequest)(nil),                       // 9: AddNonvoterRequest
+	(*ApplyBatchRequest)(nil),                        // 10: ApplyBatchRequest
+	(*ApplyBatchResponse)(nil),                       // 11: ApplyBatchResponse
+	(*ApplyLogRequest)(nil),                          // 12: ApplyLogRequest
+	(*AppliedIndexRequest)(nil),                      // 13: AppliedIndexRequest
+	(*AppliedIndexResponse)(nil),                     // 14: AppliedIndexResponse
+	(*BarrierRequest)(nil),                           // 15: BarrierRequest
+	(*BootstrapClusterRequest)(nil),                  // 16: BootstrapClusterRequest
+	(*ClusterInfoRequest)(nil),                       // 17: ClusterInfoRequest
+	(*ClusterInfoResponse)(nil),                      // 18: ClusterInfoResponse
+	(*DeleteLogRangeRequest)(nil),                    // 19: DeleteLogRangeRequest
+	(*DeleteLogRangeResponse)(nil),                   // 20: DeleteLogRangeResponse
+	(*DemoteVoterRequest)(nil),                       // 21: DemoteVoterRequest
+	(*DownloadSnapshotRequest)(nil),                  // 22: DownloadSnapshotRequest
+	(*DownloadSnapshotResponse)(nil),                 // 23: DownloadSnapshotResponse
+	(*EnterMaintenanceRequest)(nil),                  // 24: EnterMaintenanceRequest
+	(*EnterMaintenanceResponse)(nil),                 // 25: EnterMaintenanceResponse
+	(*ExitMaintenanceRequest)(nil),                   // 26: ExitMaintenanceRequest
+	(*ExitMaintenanceResponse)(nil),                  // 27: ExitMaintenanceResponse
+	(*GetConfigurationRequest)(nil),                  // 28: GetConfigurationRequest
+	(*GetConfigurationResponse)(nil),                 // 29: GetConfigurationResponse
+	(*GetIndexesRequest)(nil),                        // 30: GetIndexesRequest
+	(*GetIndexesResponse)(nil),                       // 31: GetIndexesResponse
+	(*GetLogsRequest)(nil),                           // 32: GetLogsRequest
+	(*GetLogsResponse)(nil),                          // 33: GetLogsResponse
+	(*GetMetadataRequest)(nil),                       // 34: GetMetadataRequest
+	(*GetMetadataResponse)(nil),                      // 35: GetMetadataResponse
+	(*GetReloadableConfigRequest)(nil),               // 36: GetReloadableConfigRequest
+	(*GetReloadableConfigResponse)(nil),              // 37: GetReloadableConfigResponse
+	(*GetStableStoreRequest)(nil),                    // 38: GetStableStoreRequest
+	(*GetStableStoreResponse)(nil),                   // 39: GetStableStoreResponse
+	(*GracefulShutdownRequest)(nil),                  // 40: GracefulShutdownRequest
+	(*LastContactRequest)(nil),                       // 41: LastContactRequest
+	(*LastContactResponse)(nil),                      // 42: LastContactResponse
+	(*LastIndexRequest)(nil),                         // 43: LastIndexRequest
+	(*LastIndexResponse)(nil),                        // 44: LastIndexResponse
+	(*LastSnapshotRequest)(nil),                      // 45: LastSnapshotRequest
+	(*LastSnapshotResponse)(nil),                     // 46: LastSnapshotResponse
+	(*LeaderRequest)(nil),                            // 47: LeaderRequest
+	(*LeaderResponse)(nil),                           // 48: LeaderResponse
+	(*LeadershipTransferRequest)(nil),                // 49: LeadershipTransferRequest
+	(*LeadershipTransferToServerRequest)(nil),        // 50: LeadershipTransferToServerRequest
+	(*ListClustersRequest)(nil),                      // 51: ListClustersRequest
+	(*ListClustersResponse)(nil),                     // 52: ListClustersResponse
+	(*ListOperationsRequest)(nil),                    // 53: ListOperationsRequest
+	(*ListOperationsResponse)(nil),                   // 54: ListOperationsResponse
+	(*ListPendingFuturesRequest)(nil),                // 55: ListPendingFuturesRequest
+	(*ListPendingFuturesResponse)(nil),               // 56: ListPendingFuturesResponse
+	(*ListSnapshotsRequest)(nil),                     // 57: ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),                    // 58: ListSnapshotsResponse
+	(*LogStoreStatsRequest)(nil),                     // 59: LogStoreStatsRequest
+	(*LogStoreStatsResponse)(nil),                    // 60: LogStoreStatsResponse
+	(*ObserveRequest)(nil),                           // 61: ObserveRequest
+	(*Observation)(nil),                              // 62: Observation
+	(*PeerLastContactRequest)(nil),                   // 63: PeerLastContactRequest
+	(*PeerLastContactResponse)(nil),                  // 64: PeerLastContactResponse
+	(*ReloadableConfig)(nil),                         // 65: ReloadableConfig
+	(*QuorumStatusRequest)(nil),                      // 66: QuorumStatusRequest
+	(*QuorumStatusResponse)(nil),                     // 67: QuorumStatusResponse
+	(*ReloadConfigRequest)(nil),                      // 68: ReloadConfigRequest
+	(*ReloadConfigResponse)(nil),                     // 69: ReloadConfigResponse
+	(*RemoveServerRequest)(nil),                      // 70: RemoveServerRequest
+	(*ReplicationStatusRequest)(nil),                 // 71: ReplicationStatusRequest
+	(*ReplicationStatusResponse)(nil),                // 72: ReplicationStatusResponse
+	(*RestoreSnapshotRequest)(nil),                   // 73: RestoreSnapshotRequest
+	(*RestoreSnapshotResponse)(nil),                  // 74: RestoreSnapshotResponse
+	(*ServerHealthRequest)(nil),                      // 75: ServerHealthRequest
+	(*ServerHealthResponse)(nil),                     // 76: ServerHealthResponse
+	(*ServerInfoRequest)(nil),                        // 77: ServerInfoRequest
+	(*ServerInfoResponse)(nil),                       // 78: ServerInfoResponse
+	(*SetMetadataRequest)(nil),                       // 79: SetMetadataRequest
+	(*SetMetadataResponse)(nil),                      // 80: SetMetadataResponse
+	(*ShutdownRequest)(nil),                          // 81: ShutdownRequest
+	(*SnapshotMeta)(nil),                             // 82: SnapshotMeta
+	(*SnapshotRequest)(nil),                          // 83: SnapshotRequest
+	(*StageRecoveryRequest)(nil),                     // 84: StageRecoveryRequest
+	(*StageRecoveryResponse)(nil),                    // 85: StageRecoveryResponse
+	(*StateRequest)(nil),                             // 86: StateRequest
+	(*StateResponse)(nil),                            // 87: StateResponse
+	(*StatsRequest)(nil),                             // 88: StatsRequest
+	(*StatsResponse)(nil),                            // 89: StatsResponse
+	(*StatsHistoryRequest)(nil),                      // 90: StatsHistoryRequest
+	(*StatsHistoryResponse)(nil),                     // 91: StatsHistoryResponse
+	(*StepDownRequest)(nil),                          // 92: StepDownRequest
+	(*StepDownResponse)(nil),                         // 93: StepDownResponse
+	(*VerifyFSMRequest)(nil),                         // 94: VerifyFSMRequest
+	(*VerifyFSMResponse)(nil),                        // 95: VerifyFSMResponse
+	(*VerifyLeaderRequest)(nil),                      // 96: VerifyLeaderRequest
+	(*WaitForIndexRequest)(nil),                      // 97: WaitForIndexRequest
+	(*WaitForIndexResponse)(nil),                     // 98: WaitForIndexResponse
+	(*WatchAppliedIndexRequest)(nil),                 // 99: WatchAppliedIndexRequest
+	(*WatchConfigurationRequest)(nil),                // 100: WatchConfigurationRequest
+	(*WatchLeaderRequest)(nil),                       // 101: WatchLeaderRequest
+	(*WatchStateRequest)(nil),                        // 102: WatchStateRequest
+	(*GetConfigurationResponse_Server)(nil),          // 103: GetConfigurationResponse.Server
+	nil,                                              // 104: GetConfigurationResponse.Server.MetadataEntry
+	(*GetLogsResponse_Log)(nil),                      // 105: GetLogsResponse.Log
+	nil,                                              // 106: GetMetadataResponse.MetadataEntry
+	(*ListClustersResponse_Cluster)(nil),             // 107: ListClustersResponse.Cluster
+	(*ListOperationsResponse_Operation)(nil),         // 108: ListOperationsResponse.Operation
+	(*ListPendingFuturesResponse_PendingFuture)(nil), // 109: ListPendingFuturesResponse.PendingFuture
+	(*LogStoreStatsResponse_Storage)(nil),            // 110: LogStoreStatsResponse.Storage
+	(*Observation_Leader)(nil),                       // 111: Observation.Leader
+	(*Observation_Peer)(nil),                         // 112: Observation.Peer
+	(*Observation_FailedHeartbeat)(nil),              // 113: Observation.FailedHeartbeat
+	(*Observation_ResumedHeartbeat)(nil),             // 114: Observation.ResumedHeartbeat
+	(*Observation_RequestVote)(nil),                  // 115: Observation.RequestVote
+	(*PeerLastContactResponse_Peer)(nil),             // 116: PeerLastContactResponse.Peer
+	(*ReplicationStatusResponse_Follower)(nil),       // 117: ReplicationStatusResponse.Follower
+	(*ServerHealthResponse_Server)(nil),              // 118: ServerHealthResponse.Server
+	nil,                                              // 119: SetMetadataRequest.MetadataEntry
+	nil,                                              // 120: SetMetadataResponse.MetadataEntry
+	nil,                                              // 121: StatsResponse.StatsEntry
+	(*StatsHistoryResponse_Sample)(nil),              // 122: StatsHistoryResponse.Sample
+}
+var file_raftadmin_proto_depIdxs = []int32{
+	6,   // 0: FutureResponse.result:type_name -> AwaitResponse
+	48,  // 1: AwaitResponse.leader:type_name -> LeaderResponse
+	12,  // 2: ApplyBatchRequest.entries:type_name -> ApplyLogRequest
+	6,   // 3: ApplyBatchResponse.results:type_name -> AwaitResponse
+	103, // 4: BootstrapClusterRequest.servers:type_name -> GetConfigurationResponse.Server
+	3,   // 5: ClusterInfoResponse.state:type_name -> StateResponse.State
+	48,  // 6: ClusterInfoResponse.leader:type_name -> LeaderResponse
+	29,  // 7: ClusterInfoResponse.configuration:type_name -> GetConfigurationResponse
+	31,  // 8: ClusterInfoResponse.indexes:type_name -> GetIndexesResponse
+	46,  // 9: ClusterInfoResponse.last_snapshot:type_name -> LastSnapshotResponse
+	82,  // 10: DownloadSnapshotResponse.meta:type_name -> SnapshotMeta
+	103, // 11: GetConfigurationResponse.servers:type_name -> GetConfigurationResponse.Server
+	105, // 12: GetLogsResponse.logs:type_name -> GetLogsResponse.Log
+	106, // 13: GetMetadataResponse.metadata:type_name -> GetMetadataResponse.MetadataEntry
+	65,  // 14: GetReloadableConfigResponse.config:type_name -> ReloadableConfig
+	82,  // 15: LastSnapshotResponse.meta:type_name -> SnapshotMeta
+	107, // 16: ListClustersResponse.clusters:type_name -> ListClustersResponse.Cluster
+	108, // 17: ListOperationsResponse.operations:type_name -> ListOperationsResponse.Operation
+	109, // 18: ListPendingFuturesResponse.futures:type_name -> ListPendingFuturesResponse.PendingFuture
+	82,  // 19: ListSnapshotsResponse.snapshots:type_name -> SnapshotMeta
+	110, // 20: LogStoreStatsResponse.storage:type_name -> LogStoreStatsResponse.Storage
+	2,   // 21: ObserveRequest.types:type_name -> ObserveRequest.Type
+	111, // 22: Observation.leader:type_name -> Observation.Leader
+	112, // 23: Observation.peer:type_name -> Observation.Peer
+	113, // 24: Observation.failed_heartbeat:type_name -> Observation.FailedHeartbeat
+	114, // 25: Observation.resumed_heartbeat:type_name -> Observation.ResumedHeartbeat
+	115, // 26: Observation.request_vote:type_name -> Observation.RequestVote
+	3,   // 27: Observation.state:type_name -> StateResponse.State
+	116, // 28: PeerLastContactResponse.peers:type_name -> PeerLastContactResponse.Peer
+	65,  // 29: ReloadConfigRequest.config:type_name -> ReloadableConfig
+	65,  // 30: ReloadConfigResponse.config:type_name -> ReloadableConfig
+	117, // 31: ReplicationStatusResponse.followers:type_name -> ReplicationStatusResponse.Follower
+	82,  // 32: RestoreSnapshotRequest.meta:type_name -> SnapshotMeta
+	118, // 33: ServerHealthResponse.servers:type_name -> ServerHealthResponse.Server
+	119, // 34: SetMetadataRequest.metadata:type_name -> SetMetadataRequest.MetadataEntry
+	120, // 35: SetMetadataResponse.metadata:type_name -> SetMetadataResponse.MetadataEntry
+	103, // 36: SnapshotMeta.configuration:type_name -> GetConfigurationResponse.Server
+	103, // 37: StageRecoveryRequest.servers:type_name -> GetConfigurationResponse.Server
+	3,   // 38: StateResponse.state:type_name -> StateResponse.State
+	121, // 39: StatsResponse.stats:type_name -> StatsResponse.StatsEntry
+	3,   // 40: StatsResponse.state:type_name -> StateResponse.State
+	103, // 41: StatsResponse.latest_configuration:type_name -> GetConfigurationResponse.Server
+	122, // 42: StatsHistoryResponse.samples:type_name -> StatsHistoryResponse.Sample
+	48,  // 43: StepDownResponse.new_leader:type_name -> LeaderResponse
+	0,   // 44: GetConfigurationResponse.Server.suffrage:type_name -> GetConfigurationResponse.Server.Suffrage
+	104, // 45: GetConfigurationResponse.Server.metadata:type_name -> GetConfigurationResponse.Server.MetadataEntry
+	1,   // 46: GetLogsResponse.Log.type:type_name -> GetLogsResponse.Log.Type
+	3,   // 47: ListClustersResponse.Cluster.state:type_name -> StateResponse.State
+	48,  // 48: ListClustersResponse.Cluster.leader:type_name -> LeaderResponse
+	0,   // 49: Observation.Peer.suffrage:type_name -> GetConfigurationResponse.Server.Suffrage
+	0,   // 50: ReplicationStatusResponse.Follower.suffrage:type_name -> GetConfigurationResponse.Server.Suffrage
+	0,   // 51: ServerHealthResponse.Server.suffrage:type_name -> GetConfigurationResponse.Server.Suffrage
+	3,   // 52: StatsHistoryResponse.Sample.state:type_name -> StateResponse.State
+	9,   // 53: RaftAdmin.AddNonvoter:input_type -> AddNonvoterRequest
+	8,   // 54: RaftAdmin.AddVoter:input_type -> AddVoterRequest
+	13,  // 55: RaftAdmin.AppliedIndex:input_type -> AppliedIndexRequest
+	10,  // 56: RaftAdmin.ApplyBatch:input_type -> ApplyBatchRequest
+	12,  // 57: RaftAdmin.ApplyLog:input_type -> ApplyLogRequest
+	12,  // 58: RaftAdmin.ApplyStream:input_type -> ApplyLogRequest
+	15,  // 59: RaftAdmin.Barrier:input_type -> BarrierRequest
+	16,  // 60: RaftAdmin.BootstrapCluster:input_type -> BootstrapClusterRequest
+	17,  // 61: RaftAdmin.ClusterInfo:input_type -> ClusterInfoRequest
+	19,  // 62: RaftAdmin.DeleteLogRange:input_type -> DeleteLogRangeRequest
+	21,  // 63: RaftAdmin.DemoteVoter:input_type -> DemoteVoterRequest
+	22,  // 64: RaftAdmin.DownloadSnapshot:input_type -> DownloadSnapshotRequest
+	24,  // 65: RaftAdmin.EnterMaintenance:input_type -> EnterMaintenanceRequest
+	26,  // 66: RaftAdmin.ExitMaintenance:input_type -> ExitMaintenanceRequest
+	28,  // 67: RaftAdmin.GetConfiguration:input_type -> GetConfigurationRequest
+	30,  // 68: RaftAdmin.GetIndexes:input_type -> GetIndexesRequest
+	32,  // 69: RaftAdmin.GetLogs:input_type -> GetLogsRequest
+	34,  // 70: RaftAdmin.GetMetadata:input_type -> GetMetadataRequest
+	36,  // 71: RaftAdmin.GetReloadableConfig:input_type -> GetReloadableConfigRequest
+	38,  // 72: RaftAdmin.GetStableStore:input_type -> GetStableStoreRequest
+	40,  // 73: RaftAdmin.GracefulShutdown:input_type -> GracefulShutdownRequest
+	41,  // 74: RaftAdmin.LastContact:input_type -> LastContactRequest
+	43,  // 75: RaftAdmin.LastIndex:input_type -> LastIndexRequest
+	45,  // 76: RaftAdmin.LastSnapshot:input_type -> LastSnapshotRequest
+	47,  // 77: RaftAdmin.Leader:input_type -> LeaderRequest
+	49,  // 78: RaftAdmin.LeadershipTransfer:input_type -> LeadershipTransferRequest
+	50,  // 79: RaftAdmin.LeadershipTransferToServer:input_type -> LeadershipTransferToServerRequest
+	51,  // 80: RaftAdmin.ListClusters:input_type -> ListClustersRequest
+	53,  // 81: RaftAdmin.ListOperations:input_type -> ListOperationsRequest
+	55,  // 82: RaftAdmin.ListPendingFutures:input_type -> ListPendingFuturesRequest
+	57,  // 83: RaftAdmin.ListSnapshots:input_type -> ListSnapshotsRequest
+	59,  // 84: RaftAdmin.LogStoreStats:input_type -> LogStoreStatsRequest
+	61,  // 85: RaftAdmin.Observe:input_type -> ObserveRequest
+	63,  // 86: RaftAdmin.PeerLastContact:input_type -> PeerLastContactRequest
+	66,  // 87: RaftAdmin.QuorumStatus:input_type -> QuorumStatusRequest
+	68,  // 88: RaftAdmin.ReloadConfig:input_type -> ReloadConfigRequest
+	70,  // 89: RaftAdmin.RemoveServer:input_type -> RemoveServerRequest
+	71,  // 90: RaftAdmin.ReplicationStatus:input_type -> ReplicationStatusRequest
+	73,  // 91: RaftAdmin.RestoreSnapshot:input_type -> RestoreSnapshotRequest
+	75,  // 92: RaftAdmin.ServerHealth:input_type -> ServerHealthRequest
+	77,  // 93: RaftAdmin.ServerInfo:input_type -> ServerInfoRequest
+	79,  // 94: RaftAdmin.SetMetadata:input_type -> SetMetadataRequest
+	81,  // 95: RaftAdmin.Shutdown:input_type -> ShutdownRequest
+	83,  // 96: RaftAdmin.Snapshot:input_type -> SnapshotRequest
+	84,  // 97: RaftAdmin.StageRecovery:input_type -> StageRecoveryRequest
+	86,  // 98: RaftAdmin.State:input_type -> StateRequest
+	88,  // 99: RaftAdmin.Stats:input_type -> StatsRequest
+	90,  // 100: RaftAdmin.StatsHistory:input_type -> StatsHistoryRequest
+	92,  // 101: RaftAdmin.StepDown:input_type -> StepDownRequest
+	94,  // 102: RaftAdmin.VerifyFSM:input_type -> VerifyFSMRequest
+	96,  // 103: RaftAdmin.VerifyLeader:input_type -> VerifyLeaderRequest
+	97,  // 104: RaftAdmin.WaitForIndex:input_type -> WaitForIndexRequest
+	99,  // 105: RaftAdmin.WatchAppliedIndex:input_type -> WatchAppliedIndexRequest
+	100, // 106: RaftAdmin.WatchConfiguration:input_type -> WatchConfigurationRequest
+	101, // 107: RaftAdmin.WatchLeader:input_type -> WatchLeaderRequest
+	102, // 108: RaftAdmin.WatchState:input_type -> WatchStateRequest
+	4,   // 109: RaftAdmin.Await:input_type -> Future
+	4,   // 110: RaftAdmin.Forget:input_type -> Future
+	4,   // 111: RaftAdmin.AwaitAndForget:input_type -> Future
+	13,  // 112: RaftInspect.AppliedIndex:input_type -> AppliedIndexRequest
+	17,  // 113: RaftInspect.ClusterInfo:input_type -> ClusterInfoRequest
+	28,  // 114: RaftInspect.GetConfiguration:input_type -> GetConfigurationRequest
+	30,  // 115: RaftInspect.GetIndexes:input_type -> GetIndexesRequest
+	34,  // 116: RaftInspect.GetMetadata:input_type -> GetMetadataRequest
+	36,  // 117: RaftInspect.GetReloadableConfig:input_type -> GetReloadableConfigRequest
+	38,  // 118: RaftInspect.GetStableStore:input_type -> GetStableStoreRequest
+	41,  // 119: RaftInspect.LastContact:input_type -> LastContactRequest
+	43,  // 120: RaftInspect.LastIndex:input_type -> LastIndexRequest
+	45,  // 121: RaftInspect.LastSnapshot:input_type -> LastSnapshotRequest
+	47,  // 122: RaftInspect.Leader:input_type -> LeaderRequest
+	51,  // 123: RaftInspect.ListClusters:input_type -> ListClustersRequest
+	53,  // 124: RaftInspect.ListOperations:input_type -> ListOperationsRequest
+	55,  // 125: RaftInspect.ListPendingFutures:input_type -> ListPendingFuturesRequest
+	57,  // 126: RaftInspect.ListSnapshots:input_type -> ListSnapshotsRequest
+	59,  // 127: RaftInspect.LogStoreStats:input_type -> LogStoreStatsRequest
+	61,  // 128: RaftInspect.Observe:input_type -> ObserveRequest
+	63,  // 129: RaftInspect.PeerLastContact:input_type -> PeerLastContactRequest
+	66,  // 130: RaftInspect.QuorumStatus:input_type -> QuorumStatusRequest
+	71,  // 131: RaftInspect.ReplicationStatus:input_type -> ReplicationStatusRequest
+	75,  // 132: RaftInspect.ServerHealth:input_type -> ServerHealthRequest
+	77,  // 133: RaftInspect.ServerInfo:input_type -> ServerInfoRequest
+	86,  // 134: RaftInspect.State:input_type -> StateRequest
+	88,  // 135: RaftInspect.Stats:input_type -> StatsRequest
+	90,  // 136: RaftInspect.StatsHistory:input_type -> StatsHistoryRequest
+	94,  // 137: RaftInspect.VerifyFSM:input_type -> VerifyFSMRequest
+	97,  // 138: RaftInspect.WaitForIndex:input_type -> WaitForIndexRequest
+	99,  // 139: RaftInspect.WatchAppliedIndex:input_type -> WatchAppliedIndexRequest
+	100, // 140: RaftInspect.WatchConfiguration:input_type -> WatchConfigurationRequest
+	101, // 141: RaftInspect.WatchLeader:input_type -> WatchLeaderRequest
+	102, // 142: RaftInspect.WatchState:input_type -> WatchStateRequest
+	5,   // 143: RaftAdmin.AddNonvoter:output_type -> FutureResponse
+	5,   // 144: RaftAdmin.AddVoter:output_type -> FutureResponse
+	14,  // 145: RaftAdmin.AppliedIndex:output_type -> AppliedIndexResponse
+	11,  // 146: RaftAdmin.ApplyBatch:output_type -> ApplyBatchResponse
+	5,   // 147: RaftAdmin.ApplyLog:output_type -> FutureResponse
+	6,   // 148: RaftAdmin.ApplyStream:output_type -> AwaitResponse
+	5,   // 149: RaftAdmin.Barrier:output_type -> FutureResponse
+	5,   // 150: RaftAdmin.BootstrapCluster:output_type -> FutureResponse
+	18,  // 151: RaftAdmin.ClusterInfo:output_type -> ClusterInfoResponse
+	20,  // 152: RaftAdmin.DeleteLogRange:output_type -> DeleteLogRangeResponse
+	5,   // 153: RaftAdmin.DemoteVoter:output_type -> FutureResponse
+	23,  // 154: RaftAdmin.DownloadSnapshot:output_type -> DownloadSnapshotResponse
+	25,  // 155: RaftAdmin.EnterMaintenance:output_type -> EnterMaintenanceResponse
+	27,  // 156: RaftAdmin.ExitMaintenance:output_type -> ExitMaintenanceResponse
+	29,  // 157: RaftAdmin.GetConfiguration:output_type -> GetConfigurationResponse
+	31,  // 158: RaftAdmin.GetIndexes:output_type -> GetIndexesResponse
+	33,  // 159: RaftAdmin.GetLogs:output_type -> GetLogsResponse
+	35,  // 160: RaftAdmin.GetMetadata:output_type -> GetMetadataResponse
+	37,  // 161: RaftAdmin.GetReloadableConfig:output_type -> GetReloadableConfigResponse
+	39,  // 162: RaftAdmin.GetStableStore:output_type -> GetStableStoreResponse
+	5,   // 163: RaftAdmin.GracefulShutdown:output_type -> FutureResponse
+	42,  // 164: RaftAdmin.LastContact:output_type -> LastContactResponse
+	44,  // 165: RaftAdmin.LastIndex:output_type -> LastIndexResponse
+	46,  // 166: RaftAdmin.LastSnapshot:output_type -> LastSnapshotResponse
+	48,  // 167: RaftAdmin.Leader:output_type -> LeaderResponse
+	5,   // 168: RaftAdmin.LeadershipTransfer:output_type -> FutureResponse
+	5,   // 169: RaftAdmin.LeadershipTransferToServer:output_type -> FutureResponse
+	52,  // 170: RaftAdmin.ListClusters:output_type -> ListClustersResponse
+	54,  // 171: RaftAdmin.ListOperations:output_type -> ListOperationsResponse
+	56,  // 172: RaftAdmin.ListPendingFutures:output_type -> ListPendingFuturesResponse
+	58,  // 173: RaftAdmin.ListSnapshots:output_type -> ListSnapshotsResponse
+	60,  // 174: RaftAdmin.LogStoreStats:output_type -> LogStoreStatsResponse
+	62,  // 175: RaftAdmin.Observe:output_type -> Observation
+	64,  // 176: RaftAdmin.PeerLastContact:output_type -> PeerLastContactResponse
+	67,  // 177: RaftAdmin.QuorumStatus:output_type -> QuorumStatusResponse
+	69,  // 178: RaftAdmin.ReloadConfig:output_type -> ReloadConfigResponse
+	5,   // 179: RaftAdmin.RemoveServer:output_type -> FutureResponse
+	72,  // 180: RaftAdmin.ReplicationStatus:output_type -> ReplicationStatusResponse
+	74,  // 181: RaftAdmin.RestoreSnapshot:output_type -> RestoreSnapshotResponse
+	76,  // 182: RaftAdmin.ServerHealth:output_type -> ServerHealthResponse
+	78,  // 183: RaftAdmin.ServerInfo:output_type -> ServerInfoResponse
+	80,  // 184: RaftAdmin.SetMetadata:output_type -> SetMetadataResponse
+	5,   // 185: RaftAdmin.Shutdown:output_type -> FutureResponse
+	5,   // 186: RaftAdmin.Snapshot:output_type -> FutureResponse
+	85,  // 187: RaftAdmin.StageRecovery:output_type -> StageRecoveryResponse
+	87,  // 188: RaftAdmin.State:output_type -> StateResponse
+	89,  // 189: RaftAdmin.Stats:output_type -> StatsResponse
+	91,  // 190: RaftAdmin.StatsHistory:output_type -> StatsHistoryResponse
+	93,  // 191: RaftAdmin.StepDown:output_type -> StepDownResponse
+	95,  // 192: RaftAdmin.VerifyFSM:output_type -> VerifyFSMResponse
+	5,   // 193: RaftAdmin.VerifyLeader:output_type -> FutureResponse
+	98,  // 194: RaftAdmin.WaitForIndex:output_type -> WaitForIndexResponse
+	14,  // 195: RaftAdmin.WatchAppliedIndex:output_type -> AppliedIndexResponse
+	29,  // 196: RaftAdmin.WatchConfiguration:output_type -> GetConfigurationResponse
+	48,  // 197: RaftAdmin.WatchLeader:output_type -> LeaderResponse
+	87,  // 198: RaftAdmin.WatchState:output_type -> StateResponse
+	6,   // 199: RaftAdmin.Await:output_type -> AwaitResponse
+	7,   // 200: RaftAdmin.Forget:output_type -> ForgetResponse
+	6,   // 201: RaftAdmin.AwaitAndForget:output_type -> AwaitResponse
+	14,  // 202: RaftInspect.AppliedIndex:output_type -> AppliedIndexResponse
+	18,  // 203: RaftInspect.ClusterInfo:output_type -> ClusterInfoResponse
+	29,  // 204: RaftInspect.GetConfiguration:output_type -> GetConfigurationResponse
+	31,  // 205: RaftInspect.GetIndexes:output_type -> GetIndexesResponse
+	35,  // 206: RaftInspect.GetMetadata:output_type -> GetMetadataResponse
+	37,  // 207: RaftInspect.GetReloadableConfig:output_type -> GetReloadableConfigResponse
+	39,  // 208: RaftInspect.GetStableStore:output_type -> GetStableStoreResponse
+	42,  // 209: RaftInspect.LastContact:output_type -> LastContactResponse
+	44,  // 210: RaftInspect.LastIndex:output_type -> LastIndexResponse
+	46,  // 211: RaftInspect.LastSnapshot:output_type -> LastSnapshotResponse
+	48,  // 212: RaftInspect.Leader:output_type -> LeaderResponse
+	52,  // 213: RaftInspect.ListClusters:output_type -> ListClustersResponse
+	54,  // 214: RaftInspect.ListOperations:output_type -> ListOperationsResponse
+	56,  // 215: RaftInspect.ListPendingFutures:output_type -> ListPendingFuturesResponse
+	58,  // 216: RaftInspect.ListSnapshots:output_type -> ListSnapshotsResponse
+	60,  // 217: RaftInspect.LogStoreStats:output_type -> LogStoreStatsResponse
+	62,  // 218: RaftInspect.Observe:output_type -> Observation
+	64,  // 219: RaftInspect.PeerLastContact:output_type -> PeerLastContactResponse
+	67,  // 220: RaftInspect.QuorumStatus:output_type -> QuorumStatusResponse
+	72,  // 221: RaftInspect.ReplicationStatus:output_type -> ReplicationStatusResponse
+	76,  // 222: RaftInspect.ServerHealth:output_type -> ServerHealthResponse
+	78,  // 223: RaftInspect.ServerInfo:output_type -> ServerInfoResponse
+	87,  // 224: RaftInspect.State:output_type -> StateResponse
+	89,  // 225: RaftInspect.Stats:output_type -> StatsResponse
+	91,  // 226: RaftInspect.StatsHistory:output_type -> StatsHistoryResponse
+	95,  // 227: RaftInspect.VerifyFSM:output_type -> VerifyFSMResponse
+	98,  // 228: RaftInspect.WaitForIndex:output_type -> WaitForIndexResponse
+	14,  // 229: RaftInspect.WatchAppliedIndex:output_type -> AppliedIndexResponse
+	29,  // 230: RaftInspect.WatchConfiguration:output_type -> GetConfigurationResponse
+	48,  // 231: RaftInspect.WatchLeader:output_type -> LeaderResponse
+	87,  // 232: RaftInspect.WatchState:output_type -> StateResponse
+	143, // [143:233] is the sub-list for method output_type
+	53,  // [53:143] is the sub-list for method input_type
+	53,  // [53:53] is the sub-list for extension type_name
+	53,  // [53:53] is the sub-list for extension extendee
+	0,   // [0:53] is the sub-list for field type_name
+}
+
+func init() { file_raftadmin_proto_init() }
+func file_raftadmin_proto_init() {
+	if File_raftadmin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_raftadmin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Future); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FutureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwaitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddVoterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNonvoterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppliedIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppliedIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BarrierRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLogRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLogRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DemoteVoterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnterMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnterMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIndexesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIndexesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReloadableConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReloadableConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStableStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStableStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GracefulShutdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastContactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastContactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeadershipTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeadershipTransferToServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClustersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingFuturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingFuturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStoreStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStoreStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObserveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerLastContactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerLastContactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadableConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuorumStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuorumStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageRecoveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageRecoveryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepDownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepDownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFSMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFSMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyLeaderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchAppliedIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchLeaderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigurationResponse_Server); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsResponse_Log); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClustersResponse_Cluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsResponse_Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingFuturesResponse_PendingFuture); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStoreStatsResponse_Storage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation_Leader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation_Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation_FailedHeartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation_ResumedHeartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation_RequestVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerLastContactResponse_Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationStatusResponse_Follower); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerHealthResponse_Server); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raftadmin_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsHistoryResponse_Sample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_raftadmin_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*DownloadSnapshotResponse_Meta)(nil),
+		(*DownloadSnapshotResponse_Data)(nil),
+		(*DownloadSnapshotResponse_Sha256)(nil),
+	}
+	file_raftadmin_proto_msgTypes[58].OneofWrappers = []interface{}{
+		(*Observation_Leader_)(nil),
+		(*Observation_Peer_)(nil),
+		(*Observation_FailedHeartbeat_)(nil),
+		(*Observation_ResumedHeartbeat_)(nil),
+		(*Observation_RequestVote_)(nil),
+		(*Observation_State)(nil),
+	}
+	file_raftadmin_proto_msgTypes[69].OneofWrappers = []interface{}{
+		(*RestoreSnapshotRequest_Meta)(nil),
+		(*RestoreSnapshotRequest_Data)(nil),
+		(*RestoreSnapshotRequest_Sha256)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_raftadmin_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   119,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_raftadmin_proto_goTypes,
+		DependencyIndexes: file_raftadmin_proto_depIdxs,
+		EnumInfos:         file_raftadmin_proto_enumTypes,
+		MessageInfos:      file_raftadmin_proto_msgTypes,
+	}.Build()
+	File_raftadmin_proto = out.File
+	file_raftadmin_proto_rawDesc = nil
+	file_raftadmin_proto_goTypes = nil
+	file_raftadmin_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RaftAdminClient is the client API for RaftAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RaftAdminClient interface {
+	AddNonvoter(ctx context.Context, in *AddNonvoterRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	AddVoter(ctx context.Context, in *AddVoterRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	AppliedIndex(ctx context.Context, in *AppliedIndexRequest, opts ...grpc.CallOption) (*AppliedIndexResponse, error)
+	ApplyBatch(ctx context.Context, in *ApplyBatchRequest, opts ...grpc.CallOption) (*ApplyBatchResponse, error)
+	ApplyLog(ctx context.Context, in *ApplyLogRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	// ApplyStream applies every entry sent by the client and sends back one result per entry, in the same order.
+	ApplyStream(ctx context.Context, opts ...grpc.CallOption) (RaftAdmin_ApplyStreamClient, error)
+	Barrier(ctx context.Context, in *BarrierRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	BootstrapCluster(ctx context.Context, in *BootstrapClusterRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	// ClusterInfo returns what most dashboards need in one response, so the values are consistent with each other.
+	ClusterInfo(ctx context.Context, in *ClusterInfoRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
+	// DeleteLogRange deletes entries from the LogStore to repair a corrupted log. It requires raftadmin.WithLogRepair and raft to be shut down.
+	// Call it without a confirmation_token first; that deletes nothing and returns the token to pass in a second call.
+	DeleteLogRange(ctx context.Context, in *DeleteLogRangeRequest, opts ...grpc.CallOption) (*DeleteLogRangeResponse, error)
+	DemoteVoter(ctx context.Context, in *DemoteVoterRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	DownloadSnapshot(ctx context.Context, in *DownloadSnapshotRequest, opts ...grpc.CallOption) (RaftAdmin_DownloadSnapshotClient, error)
+	// EnterMaintenance makes this node reject ApplyLog, ApplyBatch, ApplyStream, RemoveServer, RestoreSnapshot, Shutdown and GracefulShutdown until ExitMaintenance is called.
+	EnterMaintenance(ctx context.Context, in *EnterMaintenanceRequest, opts ...grpc.CallOption) (*EnterMaintenanceResponse, error)
+	ExitMaintenance(ctx context.Context, in *ExitMaintenanceRequest, opts ...grpc.CallOption) (*ExitMaintenanceResponse, error)
+	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
+	GetIndexes(ctx context.Context, in *GetIndexesRequest, opts ...grpc.CallOption) (*GetIndexesResponse, error)
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	GetReloadableConfig(ctx context.Context, in *GetReloadableConfigRequest, opts ...grpc.CallOption) (*GetReloadableConfigResponse, error)
+	// GetStableStore returns the current term and the last vote that raft persisted, and no other keys of the StableStore.
+	GetStableStore(ctx context.Context, in *GetStableStoreRequest, opts ...grpc.CallOption) (*GetStableStoreResponse, error)
+	// GracefulShutdown transfers leadership away if this node is the leader, and then shuts it down.
+	GracefulShutdown(ctx context.Context, in *GracefulShutdownRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	LastContact(ctx context.Context, in *LastContactRequest, opts ...grpc.CallOption) (*LastContactResponse, error)
+	LastIndex(ctx context.Context, in *LastIndexRequest, opts ...grpc.CallOption) (*LastIndexResponse, error)
+	LastSnapshot(ctx context.Context, in *LastSnapshotRequest, opts ...grpc.CallOption) (*LastSnapshotResponse, error)
+	Leader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (*LeaderResponse, error)
+	LeadershipTransfer(ctx context.Context, in *LeadershipTransferRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	LeadershipTransferToServer(ctx context.Context, in *LeadershipTransferToServerRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	// ListClusters returns the raft groups served by this process, see raftadmin.Clusters. It ignores the cluster metadata and lists every group the caller may call ListClusters on.
+	// Servers that host a single raft group return just that one, with an empty id.
+	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	// ListOperations returns the RPCs recently handled by this node, as recorded by raftadmin.WithOperationsLog.
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	ListPendingFutures(ctx context.Context, in *ListPendingFuturesRequest, opts ...grpc.CallOption) (*ListPendingFuturesResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// LogStoreStats reports the index range of the LogStore and, if the store supports it, its storage statistics.
+	LogStoreStats(ctx context.Context, in *LogStoreStatsRequest, opts ...grpc.CallOption) (*LogStoreStatsResponse, error)
+	Observe(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (RaftAdmin_ObserveClient, error)
+	// PeerLastContact reports for every peer whether the leader's heartbeats to it are failing, and when they were last in contact. It must be sent to the leader.
+	PeerLastContact(ctx context.Context, in *PeerLastContactRequest, opts ...grpc.CallOption) (*PeerLastContactResponse, error)
+	// QuorumStatus reports how many voters the leader can reach and how many more failures the cluster can survive. It must be sent to the leader.
+	QuorumStatus(ctx context.Context, in *QuorumStatusRequest, opts ...grpc.CallOption) (*QuorumStatusResponse, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	// RemoveServer removes a server from the configuration. It refuses to remove the leader unless force is set. On servers using raftadmin.WithConfirmation, call it without a confirmation_token first; that removes nothing and fails with FAILED_PRECONDITION, with the token and a description of the effect in a google.rpc.ErrorInfo detail.
+	RemoveServer(ctx context.Context, in *RemoveServerRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error)
+	// RestoreSnapshot replaces the state of the entire cluster with the uploaded snapshot. On servers using raftadmin.WithConfirmation, send just the metadata without a confirmation_token first; that restores nothing and fails with FAILED_PRECONDITION, with the token in a google.rpc.ErrorInfo detail.
+	RestoreSnapshot(ctx context.Context, opts ...grpc.CallOption) (RaftAdmin_RestoreSnapshotClient, error)
+	// ServerHealth reports the health of the servers in the configuration, like Consul's autopilot does. It must be sent to the leader.
+	ServerHealth(ctx context.Context, in *ServerHealthRequest, opts ...grpc.CallOption) (*ServerHealthResponse, error)
+	ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error)
+	// SetMetadata sets key/value tags on this node, like its zone or rack. Empty values remove the key.
+	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error)
+	// Shutdown shuts down raft on this node. It asks for confirmation like RemoveServer.
+	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	StageRecovery(ctx context.Context, in *StageRecoveryRequest, opts ...grpc.CallOption) (*StageRecoveryResponse, error)
+	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	// StatsHistory returns the samples taken by raftadmin.WithStatsHistory.
+	StatsHistory(ctx context.Context, in *StatsHistoryRequest, opts ...grpc.CallOption) (*StatsHistoryResponse, error)
+	// StepDown makes the leader hand off leadership to another voter and returns once it has. The node stays in the cluster.
+	StepDown(ctx context.Context, in *StepDownRequest, opts ...grpc.CallOption) (*StepDownResponse, error)
+	// VerifyFSM returns a checksum of the FSM, as computed by the function given to raftadmin.WithFSMChecksum, and the applied index it belongs to.
+	VerifyFSM(ctx context.Context, in *VerifyFSMRequest, opts ...grpc.CallOption) (*VerifyFSMResponse, error)
+	VerifyLeader(ctx context.Context, in *VerifyLeaderRequest, opts ...grpc.CallOption) (*FutureResponse, error)
+	// WaitForIndex returns once the applied index of this node has reached the given index.
+	// Like raft.AppliedIndex, that means the entry was handed to the FSM, which might still be applying it.
+	WaitForIndex(ctx context.Context, in *WaitForIndexRequest, opts ...grpc.CallOption) (*WaitForIndexResponse, error)
+	// WatchAppliedIndex sends the applied index of the node, and then the new one whenever it changes.
+	WatchAppliedIndex(ctx context.Context, in *WatchAppliedIndexRequest, opts ...grpc.CallOption) (RaftAdmin_WatchAppliedIndexClient, error)
+	// WatchConfiguration sends the latest configuration known by the node, and then the new one on every change.
+	WatchConfiguration(ctx context.Context, in *WatchConfigurationRequest, opts ...grpc.CallOption) (RaftAdmin_WatchConfigurationClient, error)
+	// WatchLeader sends the current leader as known by the node, and then the new one on every change. An empty address means there is no leader.
+	WatchLeader(ctx context.Context, in *WatchLeaderRequest, opts ...grpc.CallOption) (RaftAdmin_WatchLeaderClient, error)
+	// WatchState sends the current state of the node, and then the new state on every change.
+	WatchState(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (RaftAdmin_WatchStateClient, error)
+	Await(ctx context.Context, in *Future, opts ...grpc.CallOption) (*AwaitResponse, error)
+	Forget(ctx context.Context, in *Future, opts ...grpc.CallOption) (*ForgetResponse, error)
+	// AwaitAndForget is Await followed by Forget, in one call. If the call is cancelled while waiting, the future isn't forgotten.
+	AwaitAndForget(ctx context.Context, in *Future, opts ...grpc.CallOption) (*AwaitResponse, error)
+}
+
+type raftAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRaftAdminClient(cc grpc.ClientConnInterface) RaftAdminClient {
+	return &raftAdminClient{cc}
+}
+
+func (c *raftAdminClient) AddNonvoter(ctx context.Context, in *AddNonvoterRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/AddNonvoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) AddVoter(ctx context.Context, in *AddVoterRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/AddVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) AppliedIndex(ctx context.Context, in *AppliedIndexRequest, opts ...grpc.CallOption) (*AppliedIndexResponse, error) {
+	out := new(AppliedIndexResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/AppliedIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ApplyBatch(ctx context.Context, in *ApplyBatchRequest, opts ...grpc.CallOption) (*ApplyBatchResponse, error) {
+	out := new(ApplyBatchResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ApplyBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ApplyLog(ctx context.Context, in *ApplyLogRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ApplyLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ApplyStream(ctx context.Context, opts ...grpc.CallOption) (RaftAdmin_ApplyStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[0], "/RaftAdmin/ApplyStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminApplyStreamClient{stream}
+	return x, nil
+}
+
+type RaftAdmin_ApplyStreamClient interface {
+	Send(*ApplyLogRequest) error
+	Recv() (*AwaitResponse, error)
+	grpc.ClientStream
+}
+
+type raftAdminApplyStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminApplyStreamClient) Send(m *ApplyLogRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *raftAdminApplyStreamClient) Recv() (*AwaitResponse, error) {
+	m := new(AwaitResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) Barrier(ctx context.Context, in *BarrierRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/Barrier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) BootstrapCluster(ctx context.Context, in *BootstrapClusterRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/BootstrapCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ClusterInfo(ctx context.Context, in *ClusterInfoRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
+	out := new(ClusterInfoResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ClusterInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) DeleteLogRange(ctx context.Context, in *DeleteLogRangeRequest, opts ...grpc.CallOption) (*DeleteLogRangeResponse, error) {
+	out := new(DeleteLogRangeResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/DeleteLogRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) DemoteVoter(ctx context.Context, in *DemoteVoterRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/DemoteVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) DownloadSnapshot(ctx context.Context, in *DownloadSnapshotRequest, opts ...grpc.CallOption) (RaftAdmin_DownloadSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[1], "/RaftAdmin/DownloadSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminDownloadSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftAdmin_DownloadSnapshotClient interface {
+	Recv() (*DownloadSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type raftAdminDownloadSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminDownloadSnapshotClient) Recv() (*DownloadSnapshotResponse, error) {
+	m := new(DownloadSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) EnterMaintenance(ctx context.Context, in *EnterMaintenanceRequest, opts ...grpc.CallOption) (*EnterMaintenanceResponse, error) {
+	out := new(EnterMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/EnterMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ExitMaintenance(ctx context.Context, in *ExitMaintenanceRequest, opts ...grpc.CallOption) (*ExitMaintenanceResponse, error) {
+	out := new(ExitMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ExitMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
+	out := new(GetConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/GetConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) GetIndexes(ctx context.Context, in *GetIndexesRequest, opts ...grpc.CallOption) (*GetIndexesResponse, error) {
+	out := new(GetIndexesResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/GetIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	out := new(GetLogsResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/GetLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) GetReloadableConfig(ctx context.Context, in *GetReloadableConfigRequest, opts ...grpc.CallOption) (*GetReloadableConfigResponse, error) {
+	out := new(GetReloadableConfigResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/GetReloadableConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) GetStableStore(ctx context.Context, in *GetStableStoreRequest, opts ...grpc.CallOption) (*GetStableStoreResponse, error) {
+	out := new(GetStableStoreResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/GetStableStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) GracefulShutdown(ctx context.Context, in *GracefulShutdownRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/GracefulShutdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) LastContact(ctx context.Context, in *LastContactRequest, opts ...grpc.CallOption) (*LastContactResponse, error) {
+	out := new(LastContactResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/LastContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) LastIndex(ctx context.Context, in *LastIndexRequest, opts ...grpc.CallOption) (*LastIndexResponse, error) {
+	out := new(LastIndexResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/LastIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) LastSnapshot(ctx context.Context, in *LastSnapshotRequest, opts ...grpc.CallOption) (*LastSnapshotResponse, error) {
+	out := new(LastSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/LastSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) Leader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (*LeaderResponse, error) {
+	out := new(LeaderResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/Leader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) LeadershipTransfer(ctx context.Context, in *LeadershipTransferRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/LeadershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) LeadershipTransferToServer(ctx context.Context, in *LeadershipTransferToServerRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/LeadershipTransferToServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error) {
+	out := new(ListClustersResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ListClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ListPendingFutures(ctx context.Context, in *ListPendingFuturesRequest, opts ...grpc.CallOption) (*ListPendingFuturesResponse, error) {
+	out := new(ListPendingFuturesResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ListPendingFutures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) LogStoreStats(ctx context.Context, in *LogStoreStatsRequest, opts ...grpc.CallOption) (*LogStoreStatsResponse, error) {
+	out := new(LogStoreStatsResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/LogStoreStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) Observe(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (RaftAdmin_ObserveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[2], "/RaftAdmin/Observe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminObserveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftAdmin_ObserveClient interface {
+	Recv() (*Observation, error)
+	grpc.ClientStream
+}
+
+type raftAdminObserveClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminObserveClient) Recv() (*Observation, error) {
+	m := new(Observation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) PeerLastContact(ctx context.Context, in *PeerLastContactRequest, opts ...grpc.CallOption) (*PeerLastContactResponse, error) {
+	out := new(PeerLastContactResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/PeerLastContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) QuorumStatus(ctx context.Context, in *QuorumStatusRequest, opts ...grpc.CallOption) (*QuorumStatusResponse, error) {
+	out := new(QuorumStatusResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/QuorumStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) RemoveServer(ctx context.Context, in *RemoveServerRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/RemoveServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error) {
+	out := new(ReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ReplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) RestoreSnapshot(ctx context.Context, opts ...grpc.CallOption) (RaftAdmin_RestoreSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[3], "/RaftAdmin/RestoreSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminRestoreSnapshotClient{stream}
+	return x, nil
+}
+
+type RaftAdmin_RestoreSnapshotClient interface {
+	Send(*RestoreSnapshotRequest) error
+	CloseAndRecv() (*RestoreSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type raftAdminRestoreSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminRestoreSnapshotClient) Send(m *RestoreSnapshotRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *raftAdminRestoreSnapshotClient) CloseAndRecv() (*RestoreSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) ServerHealth(ctx context.Context, in *ServerHealthRequest, opts ...grpc.CallOption) (*ServerHealthResponse, error) {
+	out := new(ServerHealthResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ServerHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error) {
+	out := new(ServerInfoResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/ServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error) {
+	out := new(SetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/SetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/Shutdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) StageRecovery(ctx context.Context, in *StageRecoveryRequest, opts ...grpc.CallOption) (*StageRecoveryResponse, error) {
+	out := new(StageRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/StageRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
+	out := new(StateResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) StatsHistory(ctx context.Context, in *StatsHistoryRequest, opts ...grpc.CallOption) (*StatsHistoryResponse, error) {
+	out := new(StatsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/StatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) StepDown(ctx context.Context, in *StepDownRequest, opts ...grpc.CallOption) (*StepDownResponse, error) {
+	out := new(StepDownResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/StepDown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) VerifyFSM(ctx context.Context, in *VerifyFSMRequest, opts ...grpc.CallOption) (*VerifyFSMResponse, error) {
+	out := new(VerifyFSMResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/VerifyFSM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) VerifyLeader(ctx context.Context, in *VerifyLeaderRequest, opts ...grpc.CallOption) (*FutureResponse, error) {
+	out := new(FutureResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/VerifyLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) WaitForIndex(ctx context.Context, in *WaitForIndexRequest, opts ...grpc.CallOption) (*WaitForIndexResponse, error) {
+	out := new(WaitForIndexResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/WaitForIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) WatchAppliedIndex(ctx context.Context, in *WatchAppliedIndexRequest, opts ...grpc.CallOption) (RaftAdmin_WatchAppliedIndexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[4], "/RaftAdmin/WatchAppliedIndex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminWatchAppliedIndexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftAdmin_WatchAppliedIndexClient interface {
+	Recv() (*AppliedIndexResponse, error)
+	grpc.ClientStream
+}
+
+type raftAdminWatchAppliedIndexClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminWatchAppliedIndexClient) Recv() (*AppliedIndexResponse, error) {
+	m := new(AppliedIndexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) WatchConfiguration(ctx context.Context, in *WatchConfigurationRequest, opts ...grpc.CallOption) (RaftAdmin_WatchConfigurationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[5], "/RaftAdmin/WatchConfiguration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminWatchConfigurationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftAdmin_WatchConfigurationClient interface {
+	Recv() (*GetConfigurationResponse, error)
+	grpc.ClientStream
+}
+
+type raftAdminWatchConfigurationClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminWatchConfigurationClient) Recv() (*GetConfigurationResponse, error) {
+	m := new(GetConfigurationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) WatchLeader(ctx context.Context, in *WatchLeaderRequest, opts ...grpc.CallOption) (RaftAdmin_WatchLeaderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[6], "/RaftAdmin/WatchLeader", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminWatchLeaderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftAdmin_WatchLeaderClient interface {
+	Recv() (*LeaderResponse, error)
+	grpc.ClientStream
+}
+
+type raftAdminWatchLeaderClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminWatchLeaderClient) Recv() (*LeaderResponse, error) {
+	m := new(LeaderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) WatchState(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (RaftAdmin_WatchStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftAdmin_serviceDesc.Streams[7], "/RaftAdmin/WatchState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftAdminWatchStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftAdmin_WatchStateClient interface {
+	Recv() (*StateResponse, error)
+	grpc.ClientStream
+}
+
+type raftAdminWatchStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftAdminWatchStateClient) Recv() (*StateResponse, error) {
+	m := new(StateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftAdminClient) Await(ctx context.Context, in *Future, opts ...grpc.CallOption) (*AwaitResponse, error) {
+	out := new(AwaitResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/Await", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) Forget(ctx context.Context, in *Future, opts ...grpc.CallOption) (*ForgetResponse, error) {
+	out := new(ForgetResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/Forget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftAdminClient) AwaitAndForget(ctx context.Context, in *Future, opts ...grpc.CallOption) (*AwaitResponse, error) {
+	out := new(AwaitResponse)
+	err := c.cc.Invoke(ctx, "/RaftAdmin/AwaitAndForget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RaftAdminServer is the server API for RaftAdmin service.
+type RaftAdminServer interface {
+	AddNonvoter(context.Context, *AddNonvoterRequest) (*FutureResponse, error)
+	AddVoter(context.Context, *AddVoterRequest) (*FutureResponse, error)
+	AppliedIndex(context.Context, *AppliedIndexRequest) (*AppliedIndexResponse, error)
+	ApplyBatch(context.Context, *ApplyBatchRequest) (*ApplyBatchResponse, error)
+	ApplyLog(context.Context, *ApplyLogRequest) (*FutureResponse, error)
+	// ApplyStream applies every entry sent by the client and sends back one result per entry, in the same order.
+	ApplyStream(RaftAdmin_ApplyStreamServer) error
+	Barrier(context.Context, *BarrierRequest) (*FutureResponse, error)
+	BootstrapCluster(context.Context, *BootstrapClusterRequest) (*FutureResponse, error)
+	// ClusterInfo returns what most dashboards need in one response, so the values are consistent with each other.
+	ClusterInfo(context.Context, *ClusterInfoRequest) (*ClusterInfoResponse, error)
+	// DeleteLogRange deletes entries from the LogStore to repair a corrupted log. It requires raftadmin.WithLogRepair and raft to be shut down.
+	// Call it without a confirmation_token first; that deletes nothing and returns the token to pass in a second call.
+	DeleteLogRange(context.Context, *DeleteLogRangeRequest) (*DeleteLogRangeResponse, error)
+	DemoteVoter(context.Context, *DemoteVoterRequest) (*FutureResponse, error)
+	DownloadSnapshot(*DownloadSnapshotRequest, RaftAdmin_DownloadSnapshotServer) error
+	// EnterMaintenance makes this node reject ApplyLog, ApplyBatch, ApplyStream, RemoveServer, RestoreSnapshot, Shutdown and GracefulShutdown until ExitMaintenance is called.
+	EnterMaintenance(context.Context, *EnterMaintenanceRequest) (*EnterMaintenanceResponse, error)
+	ExitMaintenance(context.Context, *ExitMaintenanceRequest) (*ExitMaintenanceResponse, error)
+	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
+	GetIndexes(context.Context, *GetIndexesRequest) (*GetIndexesResponse, error)
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	GetReloadableConfig(context.Context, *GetReloadableConfigRequest) (*GetReloadableConfigResponse, error)
+	// GetStableStore returns the current term and the last vote that raft persisted, and no other keys of the StableStore.
+	GetStableStore(context.Context, *GetStableStoreRequest) (*GetStableStoreResponse, error)
+	// GracefulShutdown transfers leadership away if this node is the leader, and then shuts it down.
+	GracefulShutdown(context.Context, *GracefulShutdownRequest) (*FutureResponse, error)
+	LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error)
+	LastIndex(context.Context, *LastIndexRequest) (*LastIndexResponse, error)
+	LastSnapshot(context.Context, *LastSnapshotRequest) (*LastSnapshotResponse, error)
+	Leader(context.Context, *LeaderRequest) (*LeaderResponse, error)
+	LeadershipTransfer(context.Context, *LeadershipTransferRequest) (*FutureResponse, error)
+	LeadershipTransferToServer(context.Context, *LeadershipTransferToServerRequest) (*FutureResponse, error)
+	// ListClusters returns the raft groups served by this process, see raftadmin.Clusters. It ignores the cluster metadata and lists every group the caller may call ListClusters on.
+	// Servers that host a single raft group return just that one, with an empty id.
+	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	// ListOperations returns the RPCs recently handled by this node, as recorded by raftadmin.WithOperationsLog.
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	ListPendingFutures(context.Context, *ListPendingFuturesRequest) (*ListPendingFuturesResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// LogStoreStats reports the index range of the LogStore and, if the store supports it, its storage statistics.
+	LogStoreStats(context.Context, *LogStoreStatsRequest) (*LogStoreStatsResponse, error)
+	Observe(*ObserveRequest, RaftAdmin_ObserveServer) error
+	// PeerLastContact reports for every peer whether the leader's heartbeats to it are failing, and when they were last in contact. It must be sent to the leader.
+	PeerLastContact(context.Context, *PeerLastContactRequest) (*PeerLastContactResponse, error)
+	// QuorumStatus reports how many voters the leader can reach and how many more failures the cluster can survive. It must be sent to the leader.
+	QuorumStatus(context.Context, *QuorumStatusRequest) (*QuorumStatusResponse, error)
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	// RemoveServer removes a server from the configuration. It refuses to remove the leader unless force is set. On servers using raftadmin.WithConfirmation, call it without a confirmation_token first; that removes nothing and fails with FAILED_PRECONDITION, with the token and a description of the effect in a google.rpc.ErrorInfo detail.
+	RemoveServer(context.Context, *RemoveServerRequest) (*FutureResponse, error)
+	ReplicationStatus(context.Context, *ReplicationStatusRequest) (*ReplicationStatusResponse, error)
+	// RestoreSnapshot replaces the state of the entire cluster with the uploaded snapshot. On servers using raftadmin.WithConfirmation, send just the metadata without a confirmation_token first; that restores nothing and fails with FAILED_PRECONDITION, with the token in a google.rpc.ErrorInfo detail.
+	RestoreSnapshot(RaftAdmin_RestoreSnapshotServer) error
+	// ServerHealth reports the health of the servers in the configuration, like Consul's autopilot does. It must be sent to the leader.
+	ServerHealth(context.Context, *ServerHealthRequest) (*ServerHealthResponse, error)
+	ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error)
+	// SetMetadata sets key/value tags on this node, like its zone or rack. Empty values remove the key.
+	SetMetadata(context.Context, *SetMetadataRequest) (*SetMetadataResponse, error)
+	// Shutdown shuts down raft on this node. It asks for confirmation like RemoveServer.
+	Shutdown(context.Context, *ShutdownRequest) (*FutureResponse, error)
+	Snapshot(context.Context, *SnapshotRequest) (*FutureResponse, error)
+	StageRecovery(context.Context, *StageRecoveryRequest) (*StageRecoveryResponse, error)
+	State(context.Context, *StateRequest) (*StateResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	// StatsHistory returns the samples taken by raftadmin.WithStatsHistory.
+	StatsHistory(context.Context, *StatsHistoryRequest) (*StatsHistoryResponse, error)
+	// StepDown makes the leader hand off leadership to another voter and returns once it has. The node stays in the cluster.
+	StepDown(context.Context, *StepDownRequest) (*StepDownResponse, error)
+	// VerifyFSM returns a checksum of the FSM, as computed by the function given to raftadmin.WithFSMChecksum, and the applied index it belongs to.
+	VerifyFSM(context.Context, *VerifyFSMRequest) (*VerifyFSMResponse, error)
+	VerifyLeader(context.Context, *VerifyLeaderRequest) (*FutureResponse, error)
+	// WaitForIndex returns once the applied index of this node has reached the given index.
+	// Like raft.AppliedIndex, that means the entry was handed to the FSM, which might still be applying it.
+	WaitForIndex(context.Context, *WaitForIndexRequest) (*WaitForIndexResponse, error)
+	// WatchAppliedIndex sends the applied index of the node, and then the new one whenever it changes.
+	WatchAppliedIndex(*WatchAppliedIndexRequest, RaftAdmin_WatchAppliedIndexServer) error
+	// WatchConfiguration sends the latest configuration known by the node, and then the new one on every change.
+	WatchConfiguration(*WatchConfigurationRequest, RaftAdmin_WatchConfigurationServer) error
+	// WatchLeader sends the current leader as known by the node, and then the new one on every change. An empty address means there is no leader.
+	WatchLeader(*WatchLeaderRequest, RaftAdmin_WatchLeaderServer) error
+	// WatchState sends the current state of the node, and then the new state on every change.
+	WatchState(*WatchStateRequest, RaftAdmin_WatchStateServer) error
+	Await(context.Context, *Future) (*AwaitResponse, error)
+	Forget(context.Context, *Future) (*ForgetResponse, error)
+	// AwaitAndForget is Await followed by Forget, in one call. If the call is cancelled while waiting, the future isn't forgotten.
+	AwaitAndForget(context.Context, *Future) (*AwaitResponse, error)
+}
+
+// UnimplementedRaftAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedRaftAdminServer struct {
+}
+
+func (*UnimplementedRaftAdminServer) AddNonvoter(context.Context, *AddNonvoterRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNonvoter not implemented")
+}
+func (*UnimplementedRaftAdminServer) AddVoter(context.Context, *AddVoterRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVoter not implemented")
+}
+func (*UnimplementedRaftAdminServer) AppliedIndex(context.Context, *AppliedIndexRequest) (*AppliedIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppliedIndex not implemented")
+}
+func (*UnimplementedRaftAdminServer) ApplyBatch(context.Context, *ApplyBatchRequest) (*ApplyBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyBatch not implemented")
+}
+func (*UnimplementedRaftAdminServer) ApplyLog(context.Context, *ApplyLogRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyLog not implemented")
+}
+func (*UnimplementedRaftAdminServer) ApplyStream(RaftAdmin_ApplyStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ApplyStream not implemented")
+}
+func (*UnimplementedRaftAdminServer) Barrier(context.Context, *BarrierRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Barrier not implemented")
+}
+func (*UnimplementedRaftAdminServer) BootstrapCluster(context.Context, *BootstrapClusterRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootstrapCluster not implemented")
+}
+func (*UnimplementedRaftAdminServer) ClusterInfo(context.Context, *ClusterInfoRequest) (*ClusterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterInfo not implemented")
+}
+func (*UnimplementedRaftAdminServer) DeleteLogRange(context.Context, *DeleteLogRangeRequest) (*DeleteLogRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLogRange not implemented")
+}
+func (*UnimplementedRaftAdminServer) DemoteVoter(context.Context, *DemoteVoterRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteVoter not implemented")
+}
+func (*UnimplementedRaftAdminServer) DownloadSnapshot(*DownloadSnapshotRequest, RaftAdmin_DownloadSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadSnapshot not implemented")
+}
+func (*UnimplementedRaftAdminServer) EnterMaintenance(context.Context, *EnterMaintenanceRequest) (*EnterMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterMaintenance not implemented")
+}
+func (*UnimplementedRaftAdminServer) ExitMaintenance(context.Context, *ExitMaintenanceRequest) (*ExitMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitMaintenance not implemented")
+}
+func (*UnimplementedRaftAdminServer) GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
+}
+func (*UnimplementedRaftAdminServer) GetIndexes(context.Context, *GetIndexesRequest) (*GetIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexes not implemented")
+}
+func (*UnimplementedRaftAdminServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
+func (*UnimplementedRaftAdminServer) GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (*UnimplementedRaftAdminServer) GetReloadableConfig(context.Context, *GetReloadableConfigRequest) (*GetReloadableConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReloadableConfig not implemented")
+}
+func (*UnimplementedRaftAdminServer) GetStableStore(context.Context, *GetStableStoreRequest) (*GetStableStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStableStore not implemented")
+}
+func (*UnimplementedRaftAdminServer) GracefulShutdown(context.Context, *GracefulShutdownRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GracefulShutdown not implemented")
+}
+func (*UnimplementedRaftAdminServer) LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastContact not implemented")
+}
+func (*UnimplementedRaftAdminServer) LastIndex(context.Context, *LastIndexRequest) (*LastIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastIndex not implemented")
+}
+func (*UnimplementedRaftAdminServer) LastSnapshot(context.Context, *LastSnapshotRequest) (*LastSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSnapshot not implemented")
+}
+func (*UnimplementedRaftAdminServer) Leader(context.Context, *LeaderRequest) (*LeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leader not implemented")
+}
+func (*UnimplementedRaftAdminServer) LeadershipTransfer(context.Context, *LeadershipTransferRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeadershipTransfer not implemented")
+}
+func (*UnimplementedRaftAdminServer) LeadershipTransferToServer(context.Context, *LeadershipTransferToServerRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeadershipTransferToServer not implemented")
+}
+func (*UnimplementedRaftAdminServer) ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (*UnimplementedRaftAdminServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (*UnimplementedRaftAdminServer) ListPendingFutures(context.Context, *ListPendingFuturesRequest) (*ListPendingFuturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingFutures not implemented")
+}
+func (*UnimplementedRaftAdminServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (*UnimplementedRaftAdminServer) LogStoreStats(context.Context, *LogStoreStatsRequest) (*LogStoreStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogStoreStats not implemented")
+}
+func (*UnimplementedRaftAdminServer) Observe(*ObserveRequest, RaftAdmin_ObserveServer) error {
+	return status.Errorf(codes.Unimplemented, "method Observe not implemented")
+}
+func (*UnimplementedRaftAdminServer) PeerLastContact(context.Context, *PeerLastContactRequest) (*PeerLastContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeerLastContact not implemented")
+}
+func (*UnimplementedRaftAdminServer) QuorumStatus(context.Context, *QuorumStatusRequest) (*QuorumStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuorumStatus not implemented")
+}
+func (*UnimplementedRaftAdminServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (*UnimplementedRaftAdminServer) RemoveServer(context.Context, *RemoveServerRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveServer not implemented")
+}
+func (*UnimplementedRaftAdminServer) ReplicationStatus(context.Context, *ReplicationStatusRequest) (*ReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicationStatus not implemented")
+}
+func (*UnimplementedRaftAdminServer) RestoreSnapshot(RaftAdmin_RestoreSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (*UnimplementedRaftAdminServer) ServerHealth(context.Context, *ServerHealthRequest) (*ServerHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerHealth not implemented")
+}
+func (*UnimplementedRaftAdminServer) ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
+}
+func (*UnimplementedRaftAdminServer) SetMetadata(context.Context, *SetMetadataRequest) (*SetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
+}
+func (*UnimplementedRaftAdminServer) Shutdown(context.Context, *ShutdownRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (*UnimplementedRaftAdminServer) Snapshot(context.Context, *SnapshotRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (*UnimplementedRaftAdminServer) StageRecovery(context.Context, *StageRecoveryRequest) (*StageRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StageRecovery not implemented")
+}
+func (*UnimplementedRaftAdminServer) State(context.Context, *StateRequest) (*StateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (*UnimplementedRaftAdminServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedRaftAdminServer) StatsHistory(context.Context, *StatsHistoryRequest) (*StatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsHistory not implemented")
+}
+func (*UnimplementedRaftAdminServer) StepDown(context.Context, *StepDownRequest) (*StepDownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StepDown not implemented")
+}
+func (*UnimplementedRaftAdminServer) VerifyFSM(context.Context, *VerifyFSMRequest) (*VerifyFSMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyFSM not implemented")
+}
+func (*UnimplementedRaftAdminServer) VerifyLeader(context.Context, *VerifyLeaderRequest) (*FutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLeader not implemented")
+}
+func (*UnimplementedRaftAdminServer) WaitForIndex(context.Context, *WaitForIndexRequest) (*WaitForIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForIndex not implemented")
+}
+func (*UnimplementedRaftAdminServer) WatchAppliedIndex(*WatchAppliedIndexRequest, RaftAdmin_WatchAppliedIndexServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAppliedIndex not implemented")
+}
+func (*UnimplementedRaftAdminServer) WatchConfiguration(*WatchConfigurationRequest, RaftAdmin_WatchConfigurationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfiguration not implemented")
+}
+func (*UnimplementedRaftAdminServer) WatchLeader(*WatchLeaderRequest, RaftAdmin_WatchLeaderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLeader not implemented")
+}
+func (*UnimplementedRaftAdminServer) WatchState(*WatchStateRequest, RaftAdmin_WatchStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchState not implemented")
+}
+func (*UnimplementedRaftAdminServer) Await(context.Context, *Future) (*AwaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Await not implemented")
+}
+func (*UnimplementedRaftAdminServer) Forget(context.Context, *Future) (*ForgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forget not implemented")
+}
+func (*UnimplementedRaftAdminServer) AwaitAndForget(context.Context, *Future) (*AwaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AwaitAndForget not implemented")
+}
+
+func RegisterRaftAdminServer(s *grpc.Server, srv RaftAdminServer) {
+	s.RegisterService(&_RaftAdmin_serviceDesc, srv)
+}
+
+func _RaftAdmin_AddNonvoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNonvoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).AddNonvoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/AddNonvoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).AddNonvoter(ctx, req.(*AddNonvoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_AddVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).AddVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/AddVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).AddVoter(ctx, req.(*AddVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_AppliedIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppliedIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).AppliedIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/AppliedIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).AppliedIndex(ctx, req.(*AppliedIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ApplyBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ApplyBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ApplyBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ApplyBatch(ctx, req.(*ApplyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ApplyLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ApplyLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ApplyLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ApplyLog(ctx, req.(*ApplyLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ApplyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RaftAdminServer).ApplyStream(&raftAdminApplyStreamServer{stream})
+}
+
+type RaftAdmin_ApplyStreamServer interface {
+	Send(*AwaitResponse) error
+	Recv() (*ApplyLogRequest, error)
+	grpc.ServerStream
+}
+
+type raftAdminApplyStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminApplyStreamServer) Send(m *AwaitResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *raftAdminApplyStreamServer) Recv() (*ApplyLogRequest, error) {
+	m := new(ApplyLogRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RaftAdmin_Barrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BarrierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).Barrier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/Barrier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).Barrier(ctx, req.(*BarrierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_BootstrapCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).BootstrapCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/BootstrapCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).BootstrapCluster(ctx, req.(*BootstrapClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ClusterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ClusterInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ClusterInfo(ctx, req.(*ClusterInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_DeleteLogRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLogRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).DeleteLogRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/DeleteLogRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).DeleteLogRange(ctx, req.(*DeleteLogRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_DemoteVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).DemoteVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/DemoteVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).DemoteVoter(ctx, req.(*DemoteVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_DownloadSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftAdminServer).DownloadSnapshot(m, &raftAdminDownloadSnapshotServer{stream})
+}
+
+type RaftAdmin_DownloadSnapshotServer interface {
+	Send(*DownloadSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type raftAdminDownloadSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminDownloadSnapshotServer) Send(m *DownloadSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftAdmin_EnterMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnterMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).EnterMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/EnterMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).EnterMaintenance(ctx, req.(*EnterMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ExitMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ExitMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ExitMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ExitMaintenance(ctx, req.(*ExitMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).GetConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/GetConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_GetIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).GetIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/GetIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).GetIndexes(ctx, req.(*GetIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).GetLogs(ctx, req.(*GetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_GetReloadableConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReloadableConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).GetReloadableConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/GetReloadableConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).GetReloadableConfig(ctx, req.(*GetReloadableConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_GetStableStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStableStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).GetStableStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/GetStableStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).GetStableStore(ctx, req.(*GetStableStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_GracefulShutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GracefulShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).GracefulShutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/GracefulShutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).GracefulShutdown(ctx, req.(*GracefulShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_LastContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).LastContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/LastContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).LastContact(ctx, req.(*LastContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_LastIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).LastIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/LastIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).LastIndex(ctx, req.(*LastIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_LastSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).LastSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/LastSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).LastSnapshot(ctx, req.(*LastSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_Leader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).Leader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/Leader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).Leader(ctx, req.(*LeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_LeadershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).LeadershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/LeadershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).LeadershipTransfer(ctx, req.(*LeadershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_LeadershipTransferToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadershipTransferToServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).LeadershipTransferToServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/LeadershipTransferToServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).LeadershipTransferToServer(ctx, req.(*LeadershipTransferToServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ListClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ListClusters(ctx, req.(*ListClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ListPendingFutures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingFuturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ListPendingFutures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ListPendingFutures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ListPendingFutures(ctx, req.(*ListPendingFuturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_LogStoreStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStoreStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).LogStoreStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/LogStoreStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).LogStoreStats(ctx, req.(*LogStoreStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_Observe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftAdminServer).Observe(m, &raftAdminObserveServer{stream})
+}
+
+type RaftAdmin_ObserveServer interface {
+	Send(*Observation) error
+	grpc.ServerStream
+}
+
+type raftAdminObserveServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminObserveServer) Send(m *Observation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftAdmin_PeerLastContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerLastContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).PeerLastContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/PeerLastContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).PeerLastContact(ctx, req.(*PeerLastContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_QuorumStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuorumStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).QuorumStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/QuorumStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).QuorumStatus(ctx, req.(*QuorumStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_RemoveServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).RemoveServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/RemoveServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).RemoveServer(ctx, req.(*RemoveServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ReplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ReplicationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ReplicationStatus(ctx, req.(*ReplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_RestoreSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RaftAdminServer).RestoreSnapshot(&raftAdminRestoreSnapshotServer{stream})
+}
+
+type RaftAdmin_RestoreSnapshotServer interface {
+	SendAndClose(*RestoreSnapshotResponse) error
+	Recv() (*RestoreSnapshotRequest, error)
+	grpc.ServerStream
+}
+
+type raftAdminRestoreSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminRestoreSnapshotServer) SendAndClose(m *RestoreSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *raftAdminRestoreSnapshotServer) Recv() (*RestoreSnapshotRequest, error) {
+	m := new(RestoreSnapshotRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RaftAdmin_ServerHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ServerHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ServerHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ServerHealth(ctx, req.(*ServerHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).ServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/ServerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).ServerInfo(ctx, req.(*ServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).SetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/SetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).SetMetadata(ctx, req.(*SetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).Shutdown(ctx, req.(*ShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).Snapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_StageRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StageRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).StageRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/StageRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).StageRecovery(ctx, req.(*StageRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).State(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_StatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).StatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/StatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).StatsHistory(ctx, req.(*StatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_StepDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepDownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).StepDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/StepDown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).StepDown(ctx, req.(*StepDownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_VerifyFSM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyFSMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).VerifyFSM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/VerifyFSM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).VerifyFSM(ctx, req.(*VerifyFSMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_VerifyLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).VerifyLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/VerifyLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).VerifyLeader(ctx, req.(*VerifyLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_WaitForIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).WaitForIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/WaitForIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).WaitForIndex(ctx, req.(*WaitForIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_WatchAppliedIndex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAppliedIndexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftAdminServer).WatchAppliedIndex(m, &raftAdminWatchAppliedIndexServer{stream})
+}
+
+type RaftAdmin_WatchAppliedIndexServer interface {
+	Send(*AppliedIndexResponse) error
+	grpc.ServerStream
+}
+
+type raftAdminWatchAppliedIndexServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminWatchAppliedIndexServer) Send(m *AppliedIndexResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftAdmin_WatchConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigurationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftAdminServer).WatchConfiguration(m, &raftAdminWatchConfigurationServer{stream})
+}
+
+type RaftAdmin_WatchConfigurationServer interface {
+	Send(*GetConfigurationResponse) error
+	grpc.ServerStream
+}
+
+type raftAdminWatchConfigurationServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminWatchConfigurationServer) Send(m *GetConfigurationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftAdmin_WatchLeader_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLeaderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftAdminServer).WatchLeader(m, &raftAdminWatchLeaderServer{stream})
+}
+
+type RaftAdmin_WatchLeaderServer interface {
+	Send(*LeaderResponse) error
+	grpc.ServerStream
+}
+
+type raftAdminWatchLeaderServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminWatchLeaderServer) Send(m *LeaderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftAdmin_WatchState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftAdminServer).WatchState(m, &raftAdminWatchStateServer{stream})
+}
+
+type RaftAdmin_WatchStateServer interface {
+	Send(*StateResponse) error
+	grpc.ServerStream
+}
+
+type raftAdminWatchStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftAdminWatchStateServer) Send(m *StateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftAdmin_Await_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Future)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).Await(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/Await",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).Await(ctx, req.(*Future))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_Forget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Future)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).Forget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/Forget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).Forget(ctx, req.(*Future))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftAdmin_AwaitAndForget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Future)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftAdminServer).AwaitAndForget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftAdmin/AwaitAndForget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftAdminServer).AwaitAndForget(ctx, req.(*Future))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RaftAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "RaftAdmin",
+	HandlerType: (*RaftAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddNonvoter",
+			Handler:    _RaftAdmin_AddNonvoter_Handler,
+		},
+		{
+			MethodName: "AddVoter",
+			Handler:    _RaftAdmin_AddVoter_Handler,
+		},
+		{
+			MethodName: "AppliedIndex",
+			Handler:    _RaftAdmin_AppliedIndex_Handler,
+		},
+		{
+			MethodName: "ApplyBatch",
+			Handler:    _RaftAdmin_ApplyBatch_Handler,
+		},
+		{
+			MethodName: "ApplyLog",
+			Handler:    _RaftAdmin_ApplyLog_Handler,
+		},
+		{
+			MethodName: "Barrier",
+			Handler:    _RaftAdmin_Barrier_Handler,
+		},
+		{
+			MethodName: "BootstrapCluster",
+			Handler:    _RaftAdmin_BootstrapCluster_Handler,
+		},
+		{
+			MethodName: "ClusterInfo",
+			Handler:    _RaftAdmin_ClusterInfo_Handler,
+		},
+		{
+			MethodName: "DeleteLogRange",
+			Handler:    _RaftAdmin_DeleteLogRange_Handler,
+		},
+		{
+			MethodName: "DemoteVoter",
+			Handler:    _RaftAdmin_DemoteVoter_Handler,
+		},
+		{
+			MethodName: "EnterMaintenance",
+			Handler:    _RaftAdmin_EnterMaintenance_Handler,
+		},
+		{
+			MethodName: "ExitMaintenance",
+			Handler:    _RaftAdmin_ExitMaintenance_Handler,
+		},
+		{
+			MethodName: "GetConfiguration",
+			Handler:    _RaftAdmin_GetConfiguration_Handler,
+		},
+		{
+			MethodName: "GetIndexes",
+			Handler:    _RaftAdmin_GetIndexes_Handler,
+		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _RaftAdmin_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _RaftAdmin_GetMetadata_Handler,
+		},
+		{
+			MethodName: "GetReloadableConfig",
+			Handler:    _RaftAdmin_GetReloadableConfig_Handler,
+		},
+		{
+			MethodName: "GetStableStore",
+			Handler:    _RaftAdmin_GetStableStore_Handler,
+		},
+		{
+			MethodName: "GracefulShutdown",
+			Handler:    _RaftAdmin_GracefulShutdown_Handler,
+		},
+		{
+			MethodName: "LastContact",
+			Handler:    _RaftAdmin_LastContact_Handler,
+		},
+		{
+			MethodName: "LastIndex",
+			Handler:    _RaftAdmin_LastIndex_Handler,
+		},
+		{
+			MethodName: "LastSnapshot",
+			Handler:    _RaftAdmin_LastSnapshot_Handler,
+		},
+		{
+			MethodName: "Leader",
+			Handler:    _RaftAdmin_Leader_Handler,
+		},
+		{
+			MethodName: "LeadershipTransfer",
+			Handler:    _RaftAdmin_LeadershipTransfer_Handler,
+		},
+		{
+			MethodName: "LeadershipTransferToServer",
+			Handler:    _RaftAdmin_LeadershipTransferToServer_Handler,
+		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _RaftAdmin_ListClusters_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _RaftAdmin_ListOperations_Handler,
+		},
+		{
+			MethodName: "ListPendingFutures",
+			Handler:    _RaftAdmin_ListPendingFutures_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _RaftAdmin_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "LogStoreStats",
+			Handler:    _RaftAdmin_LogStoreStats_Handler,
+		},
+		{
+			MethodName: "PeerLastContact",
+			Handler:    _RaftAdmin_PeerLastContact_Handler,
+		},
+		{
+			MethodName: "QuorumStatus",
+			Handler:    _RaftAdmin_QuorumStatus_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _RaftAdmin_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "RemoveServer",
+			Handler:    _RaftAdmin_RemoveServer_Handler,
+		},
+		{
+			MethodName: "ReplicationStatus",
+			Handler:    _RaftAdmin_ReplicationStatus_Handler,
+		},
+		{
+			MethodName: "ServerHealth",
+			Handler:    _RaftAdmin_ServerHealth_Handler,
+		},
+		{
+			MethodName: "ServerInfo",
+			Handler:    _RaftAdmin_ServerInfo_Handler,
+		},
+		{
+			MethodName: "SetMetadata",
+			Handler:    _RaftAdmin_SetMetadata_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _RaftAdmin_Shutdown_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _RaftAdmin_Snapshot_Handler,
+		},
+		{
+			MethodName: "StageRecovery",
+			Handler:    _RaftAdmin_StageRecovery_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _RaftAdmin_State_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _RaftAdmin_Stats_Handler,
+		},
+		{
+			MethodName: "StatsHistory",
+			Handler:    _RaftAdmin_StatsHistory_Handler,
+		},
+		{
+			MethodName: "StepDown",
+			Handler:    _RaftAdmin_StepDown_Handler,
+		},
+		{
+			MethodName: "VerifyFSM",
+			Handler:    _RaftAdmin_VerifyFSM_Handler,
+		},
+		{
+			MethodName: "VerifyLeader",
+			Handler:    _RaftAdmin_VerifyLeader_Handler,
+		},
+		{
+			MethodName: "WaitForIndex",
+			Handler:    _RaftAdmin_WaitForIndex_Handler,
+		},
+		{
+			MethodName: "Await",
+			Handler:    _RaftAdmin_Await_Handler,
+		},
+		{
+			MethodName: "Forget",
+			Handler:    _RaftAdmin_Forget_Handler,
+		},
+		{
+			MethodName: "AwaitAndForget",
+			Handler:    _RaftAdmin_AwaitAndForget_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ApplyStream",
+			Handler:       _RaftAdmin_ApplyStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadSnapshot",
+			Handler:       _RaftAdmin_DownloadSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Observe",
+			Handler:       _RaftAdmin_Observe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreSnapshot",
+			Handler:       _RaftAdmin_RestoreSnapshot_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchAppliedIndex",
+			Handler:       _RaftAdmin_WatchAppliedIndex_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchConfiguration",
+			Handler:       _RaftAdmin_WatchConfiguration_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchLeader",
+			Handler:       _RaftAdmin_WatchLeader_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchState",
+			Handler:       _RaftAdmin_WatchState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "raftadmin.proto",
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// RaftAdminClient is the client API for RaftAdmin service.
+// RaftInspectClient is the client API for RaftInspect service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RaftAdminClient interface {
-	AddNonvoter(ctx context.Context, in *AddNonvoterRequest, opts ...grpc.CallOption) (*Future, error)
-	AddVoter(ctx context.Context, in *AddVoterRequest, opts ...grpc.CallOption) (*Future, error)
+type RaftInspectClient interface {
 	AppliedIndex(ctx context.Context, in *AppliedIndexRequest, opts ...grpc.CallOption) (*AppliedIndexResponse, error)
-	ApplyLog(ctx context.Context, in *ApplyLogRequest, opts ...grpc.CallOption) (*Future, error)
-	Barrier(ctx context.Context, in *BarrierRequest, opts ...grpc.CallOption) (*Future, error)
-	DemoteVoter(ctx context.Context, in *DemoteVoterRequest, opts ...grpc.CallOption) (*Future, error)
+	ClusterInfo(ctx context.Context, in *ClusterInfoRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
 	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
+	GetIndexes(ctx context.Context, in *GetIndexesRequest, opts ...grpc.CallOption) (*GetIndexesResponse, error)
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	GetReloadableConfig(ctx context.Context, in *GetReloadableConfigRequest, opts ...grpc.CallOption) (*GetReloadableConfigResponse, error)
+	GetStableStore(ctx context.Context, in *GetStableStoreRequest, opts ...grpc.CallOption) (*GetStableStoreResponse, error)
 	LastContact(ctx context.Context, in *LastContactRequest, opts ...grpc.CallOption) (*LastContactResponse, error)
 	LastIndex(ctx context.Context, in *LastIndexRequest, opts ...grpc.CallOption) (*LastIndexResponse, error)
+	LastSnapshot(ctx context.Context, in *LastSnapshotRequest, opts ...grpc.CallOption) (*LastSnapshotResponse, error)
 	Leader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (*LeaderResponse, error)
-	LeadershipTransfer(ctx context.Context, in *LeadershipTransferRequest, opts ...grpc.CallOption) (*Future, error)
-	LeadershipTransferToServer(ctx context.Context, in *LeadershipTransferToServerRequest, opts ...grpc.CallOption) (*Future, error)
-	RemoveServer(ctx context.Context, in *RemoveServerRequest, opts ...grpc.CallOption) (*Future, error)
-	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*Future, error)
-	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*Future, error)
+	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	ListPendingFutures(ctx context.Context, in *ListPendingFuturesRequest, opts ...grpc.CallOption) (*ListPendingFuturesResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	LogStoreStats(ctx context.Context, in *LogStoreStatsRequest, opts ...grpc.CallOption) (*LogStoreStatsResponse, error)
+	Observe(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (RaftInspect_ObserveClient, error)
+	PeerLastContact(ctx context.Context, in *PeerLastContactRequest, opts ...grpc.CallOption) (*PeerLastContactResponse, error)
+	QuorumStatus(ctx context.Context, in *QuorumStatusRequest, opts ...grpc.CallOption) (*QuorumStatusResponse, error)
+	ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error)
+	ServerHealth(ctx context.Context, in *ServerHealthRequest, opts ...grpc.CallOption) (*ServerHealthResponse, error)
+	ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error)
 	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error)
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
-	VerifyLeader(ctx context.Context, in *VerifyLeaderRequest, opts ...grpc.CallOption) (*Future, error)
-	Await(ctx context.Context, in *Future, opts ...grpc.CallOption) (*AwaitResponse, error)
-	Forget(ctx context.Context, in *Future, opts ...grpc.CallOption) (*ForgetResponse, error)
+	StatsHistory(ctx context.Context, in *StatsHistoryRequest, opts ...grpc.CallOption) (*StatsHistoryResponse, error)
+	VerifyFSM(ctx context.Context, in *VerifyFSMRequest, opts ...grpc.CallOption) (*VerifyFSMResponse, error)
+	WaitForIndex(ctx context.Context, in *WaitForIndexRequest, opts ...grpc.CallOption) (*WaitForIndexResponse, error)
+	WatchAppliedIndex(ctx context.Context, in *WatchAppliedIndexRequest, opts ...grpc.CallOption) (RaftInspect_WatchAppliedIndexClient, error)
+	WatchConfiguration(ctx context.Context, in *WatchConfigurationRequest, opts ...grpc.CallOption) (RaftInspect_WatchConfigurationClient, error)
+	WatchLeader(ctx context.Context, in *WatchLeaderRequest, opts ...grpc.CallOption) (RaftInspect_WatchLeaderClient, error)
+	WatchState(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (RaftInspect_WatchStateClient, error)
 }
 
-type raftAdminClient struct {
+type raftInspectClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewRaftAdminClient(cc grpc.ClientConnInterface) RaftAdminClient {
-	return &raftAdminClient{cc}
+func NewRaftInspectClient(cc grpc.ClientConnInterface) RaftInspectClient {
+	return &raftInspectClient{cc}
 }
 
-func (c *raftAdminClient) AddNonvoter(ctx context.Context, in *AddNonvoterRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/AddNonvoter", in, out, opts...)
+func (c *raftInspectClient) AppliedIndex(ctx context.Context, in *AppliedIndexRequest, opts ...grpc.CallOption) (*AppliedIndexResponse, error) {
+	out := new(AppliedIndexResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/AppliedIndex", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) AddVoter(ctx context.Context, in *AddVoterRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/AddVoter", in, out, opts...)
+func (c *raftInspectClient) ClusterInfo(ctx context.Context, in *ClusterInfoRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
+	out := new(ClusterInfoResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ClusterInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) AppliedIndex(ctx context.Context, in *AppliedIndexRequest, opts ...grpc.CallOption) (*AppliedIndexResponse, error) {
-	out := new(AppliedIndexResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/AppliedIndex", in, out, opts...)
+func (c *raftInspectClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
+	out := new(GetConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/GetConfiguration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) ApplyLog(ctx context.Context, in *ApplyLogRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/ApplyLog", in, out, opts...)
+func (c *raftInspectClient) GetIndexes(ctx context.Context, in *GetIndexesRequest, opts ...grpc.CallOption) (*GetIndexesResponse, error) {
+	out := new(GetIndexesResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/GetIndexes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) Barrier(ctx context.Context, in *BarrierRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/Barrier", in, out, opts...)
+func (c *raftInspectClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/GetMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) DemoteVoter(ctx context.Context, in *DemoteVoterRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/DemoteVoter", in, out, opts...)
+func (c *raftInspectClient) GetReloadableConfig(ctx context.Context, in *GetReloadableConfigRequest, opts ...grpc.CallOption) (*GetReloadableConfigResponse, error) {
+	out := new(GetReloadableConfigResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/GetReloadableConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
-	out := new(GetConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/GetConfiguration", in, out, opts...)
+func (c *raftInspectClient) GetStableStore(ctx context.Context, in *GetStableStoreRequest, opts ...grpc.CallOption) (*GetStableStoreResponse, error) {
+	out := new(GetStableStoreResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/GetStableStore", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) LastContact(ctx context.Context, in *LastContactRequest, opts ...grpc.CallOption) (*LastContactResponse, error) {
+func (c *raftInspectClient) LastContact(ctx context.Context, in *LastContactRequest, opts ...grpc.CallOption) (*LastContactResponse, error) {
 	out := new(LastContactResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/LastContact", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/RaftInspect/LastContact", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) LastIndex(ctx context.Context, in *LastIndexRequest, opts ...grpc.CallOption) (*LastIndexResponse, error) {
+func (c *raftInspectClient) LastIndex(ctx context.Context, in *LastIndexRequest, opts ...grpc.CallOption) (*LastIndexResponse, error) {
 	out := new(LastIndexResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/LastIndex", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/RaftInspect/LastIndex", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) Leader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (*LeaderResponse, error) {
+func (c *raftInspectClient) LastSnapshot(ctx context.Context, in *LastSnapshotRequest, opts ...grpc.CallOption) (*LastSnapshotResponse, error) {
+	out := new(LastSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/LastSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftInspectClient) Leader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (*LeaderResponse, error) {
 	out := new(LeaderResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/Leader", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/RaftInspect/Leader", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) LeadershipTransfer(ctx context.Context, in *LeadershipTransferRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/LeadershipTransfer", in, out, opts...)
+func (c *raftInspectClient) ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error) {
+	out := new(ListClustersResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ListClusters", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) LeadershipTransferToServer(ctx context.Context, in *LeadershipTransferToServerRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/LeadershipTransferToServer", in, out, opts...)
+func (c *raftInspectClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ListOperations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) RemoveServer(ctx context.Context, in *RemoveServerRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/RemoveServer", in, out, opts...)
+func (c *raftInspectClient) ListPendingFutures(ctx context.Context, in *ListPendingFuturesRequest, opts ...grpc.CallOption) (*ListPendingFuturesResponse, error) {
+	out := new(ListPendingFuturesResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ListPendingFutures", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/Shutdown", in, out, opts...)
+func (c *raftInspectClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ListSnapshots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/Snapshot", in, out, opts...)
+func (c *raftInspectClient) LogStoreStats(ctx context.Context, in *LogStoreStatsRequest, opts ...grpc.CallOption) (*LogStoreStatsResponse, error) {
+	out := new(LogStoreStatsResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/LogStoreStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
+func (c *raftInspectClient) Observe(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (RaftInspect_ObserveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftInspect_serviceDesc.Streams[0], "/RaftInspect/Observe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftInspectObserveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftInspect_ObserveClient interface {
+	Recv() (*Observation, error)
+	grpc.ClientStream
+}
+
+type raftInspectObserveClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftInspectObserveClient) Recv() (*Observation, error) {
+	m := new(Observation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftInspectClient) PeerLastContact(ctx context.Context, in *PeerLastContactRequest, opts ...grpc.CallOption) (*PeerLastContactResponse, error) {
+	out := new(PeerLastContactResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/PeerLastContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftInspectClient) QuorumStatus(ctx context.Context, in *QuorumStatusRequest, opts ...grpc.CallOption) (*QuorumStatusResponse, error) {
+	out := new(QuorumStatusResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/QuorumStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftInspectClient) ReplicationStatus(ctx context.Context, in *ReplicationStatusRequest, opts ...grpc.CallOption) (*ReplicationStatusResponse, error) {
+	out := new(ReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ReplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftInspectClient) ServerHealth(ctx context.Context, in *ServerHealthRequest, opts ...grpc.CallOption) (*ServerHealthResponse, error) {
+	out := new(ServerHealthResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ServerHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftInspectClient) ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error) {
+	out := new(ServerInfoResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/ServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftInspectClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
 	out := new(StateResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/State", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/RaftInspect/State", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+func (c *raftInspectClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
 	out := new(StatsResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/Stats", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/RaftInspect/Stats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) VerifyLeader(ctx context.Context, in *VerifyLeaderRequest, opts ...grpc.CallOption) (*Future, error) {
-	out := new(Future)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/VerifyLeader", in, out, opts...)
+func (c *raftInspectClient) StatsHistory(ctx context.Context, in *StatsHistoryRequest, opts ...grpc.CallOption) (*StatsHistoryResponse, error) {
+	out := new(StatsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/StatsHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) Await(ctx context.Context, in *Future, opts ...grpc.CallOption) (*AwaitResponse, error) {
-	out := new(AwaitResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/Await", in, out, opts...)
+func (c *raftInspectClient) VerifyFSM(ctx context.Context, in *VerifyFSMRequest, opts ...grpc.CallOption) (*VerifyFSMResponse, error) {
+	out := new(VerifyFSMResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/VerifyFSM", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *raftAdminClient) Forget(ctx context.Context, in *Future, opts ...grpc.CallOption) (*ForgetResponse, error) {
-	out := new(ForgetResponse)
-	err := c.cc.Invoke(ctx, "/RaftAdmin/Forget", in, out, opts...)
+func (c *raftInspectClient) WaitForIndex(ctx context.Context, in *WaitForIndexRequest, opts ...grpc.CallOption) (*WaitForIndexResponse, error) {
+	out := new(WaitForIndexResponse)
+	err := c.cc.Invoke(ctx, "/RaftInspect/WaitForIndex", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RaftAdminServer is the server API for RaftAdmin service.
-type RaftAdminServer interface {
-	AddNonvoter(context.Context, *AddNonvoterRequest) (*Future, error)
-	AddVoter(context.Context, *AddVoterRequest) (*Future, error)
+func (c *raftInspectClient) WatchAppliedIndex(ctx context.Context, in *WatchAppliedIndexRequest, opts ...grpc.CallOption) (RaftInspect_WatchAppliedIndexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftInspect_serviceDesc.Streams[1], "/RaftInspect/WatchAppliedIndex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftInspectWatchAppliedIndexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftInspect_WatchAppliedIndexClient interface {
+	Recv() (*AppliedIndexResponse, error)
+	grpc.ClientStream
+}
+
+type raftInspectWatchAppliedIndexClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftInspectWatchAppliedIndexClient) Recv() (*AppliedIndexResponse, error) {
+	m := new(AppliedIndexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftInspectClient) WatchConfiguration(ctx context.Context, in *WatchConfigurationRequest, opts ...grpc.CallOption) (RaftInspect_WatchConfigurationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftInspect_serviceDesc.Streams[2], "/RaftInspect/WatchConfiguration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftInspectWatchConfigurationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftInspect_WatchConfigurationClient interface {
+	Recv() (*GetConfigurationResponse, error)
+	grpc.ClientStream
+}
+
+type raftInspectWatchConfigurationClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftInspectWatchConfigurationClient) Recv() (*GetConfigurationResponse, error) {
+	m := new(GetConfigurationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftInspectClient) WatchLeader(ctx context.Context, in *WatchLeaderRequest, opts ...grpc.CallOption) (RaftInspect_WatchLeaderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftInspect_serviceDesc.Streams[3], "/RaftInspect/WatchLeader", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftInspectWatchLeaderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftInspect_WatchLeaderClient interface {
+	Recv() (*LeaderResponse, error)
+	grpc.ClientStream
+}
+
+type raftInspectWatchLeaderClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftInspectWatchLeaderClient) Recv() (*LeaderResponse, error) {
+	m := new(LeaderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *raftInspectClient) WatchState(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (RaftInspect_WatchStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RaftInspect_serviceDesc.Streams[4], "/RaftInspect/WatchState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftInspectWatchStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaftInspect_WatchStateClient interface {
+	Recv() (*StateResponse, error)
+	grpc.ClientStream
+}
+
+type raftInspectWatchStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftInspectWatchStateClient) Recv() (*StateResponse, error) {
+	m := new(StateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RaftInspectServer is the server API for RaftInspect service.
+type RaftInspectServer interface {
 	AppliedIndex(context.Context, *AppliedIndexRequest) (*AppliedIndexResponse, error)
-	ApplyLog(context.Context, *ApplyLogRequest) (*Future, error)
-	Barrier(context.Context, *BarrierRequest) (*Future, error)
-	DemoteVoter(context.Context, *DemoteVoterRequest) (*Future, error)
+	ClusterInfo(context.Context, *ClusterInfoRequest) (*ClusterInfoResponse, error)
 	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
+	GetIndexes(context.Context, *GetIndexesRequest) (*GetIndexesResponse, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	GetReloadableConfig(context.Context, *GetReloadableConfigRequest) (*GetReloadableConfigResponse, error)
+	GetStableStore(context.Context, *GetStableStoreRequest) (*GetStableStoreResponse, error)
 	LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error)
 	LastIndex(context.Context, *LastIndexRequest) (*LastIndexResponse, error)
+	LastSnapshot(context.Context, *LastSnapshotRequest) (*LastSnapshotResponse, error)
 	Leader(context.Context, *LeaderRequest) (*LeaderResponse, error)
-	LeadershipTransfer(context.Context, *LeadershipTransferRequest) (*Future, error)
-	LeadershipTransferToServer(context.Context, *LeadershipTransferToServerRequest) (*Future, error)
-	RemoveServer(context.Context, *RemoveServerRequest) (*Future, error)
-	Shutdown(context.Context, *ShutdownRequest) (*Future, error)
-	Snapshot(context.Context, *SnapshotRequest) (*Future, error)
+	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	ListPendingFutures(context.Context, *ListPendingFuturesRequest) (*ListPendingFuturesResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	LogStoreStats(context.Context, *LogStoreStatsRequest) (*LogStoreStatsResponse, error)
+	Observe(*ObserveRequest, RaftInspect_ObserveServer) error
+	PeerLastContact(context.Context, *PeerLastContactRequest) (*PeerLastContactResponse, error)
+	QuorumStatus(context.Context, *QuorumStatusRequest) (*QuorumStatusResponse, error)
+	ReplicationStatus(context.Context, *ReplicationStatusRequest) (*ReplicationStatusResponse, error)
+	ServerHealth(context.Context, *ServerHealthRequest) (*ServerHealthResponse, error)
+	ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error)
 	State(context.Context, *StateRequest) (*StateResponse, error)
 	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
-	VerifyLeader(context.Context, *VerifyLeaderRequest) (*Future, error)
-	Await(context.Context, *Future) (*AwaitResponse, error)
-	Forget(context.Context, *Future) (*ForgetResponse, error)
+	StatsHistory(context.Context, *StatsHistoryRequest) (*StatsHistoryResponse, error)
+	VerifyFSM(context.Context, *VerifyFSMRequest) (*VerifyFSMResponse, error)
+	WaitForIndex(context.Context, *WaitForIndexRequest) (*WaitForIndexResponse, error)
+	WatchAppliedIndex(*WatchAppliedIndexRequest, RaftInspect_WatchAppliedIndexServer) error
+	WatchConfiguration(*WatchConfigurationRequest, RaftInspect_WatchConfigurationServer) error
+	WatchLeader(*WatchLeaderRequest, RaftInspect_WatchLeaderServer) error
+	WatchState(*WatchStateRequest, RaftInspect_WatchStateServer) error
 }
 
-// UnimplementedRaftAdminServer can be embedded to have forward compatible implementations.
-type UnimplementedRaftAdminServer struct {
+// UnimplementedRaftInspectServer can be embedded to have forward compatible implementations.
+type UnimplementedRaftInspectServer struct {
 }
 
-func (*UnimplementedRaftAdminServer) AddNonvoter(context.Context, *AddNonvoterRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNonvoter not implemented")
+func (*UnimplementedRaftInspectServer) AppliedIndex(context.Context, *AppliedIndexRequest) (*AppliedIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppliedIndex not implemented")
 }
-func (*UnimplementedRaftAdminServer) AddVoter(context.Context, *AddVoterRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVoter not implemented")
+func (*UnimplementedRaftInspectServer) ClusterInfo(context.Context, *ClusterInfoRequest) (*ClusterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterInfo not implemented")
 }
-func (*UnimplementedRaftAdminServer) AppliedIndex(context.Context, *AppliedIndexRequest) (*AppliedIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppliedIndex not implemented")
+func (*UnimplementedRaftInspectServer) GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
-func (*UnimplementedRaftAdminServer) ApplyLog(context.Context, *ApplyLogRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplyLog not implemented")
+func (*UnimplementedRaftInspectServer) GetIndexes(context.Context, *GetIndexesRequest) (*GetIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexes not implemented")
 }
-func (*UnimplementedRaftAdminServer) Barrier(context.Context, *BarrierRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Barrier not implemented")
+func (*UnimplementedRaftInspectServer) GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
 }
-func (*UnimplementedRaftAdminServer) DemoteVoter(context.Context, *DemoteVoterRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemoteVoter not implemented")
+func (*UnimplementedRaftInspectServer) GetReloadableConfig(context.Context, *GetReloadableConfigRequest) (*GetReloadableConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReloadableConfig not implemented")
 }
-func (*UnimplementedRaftAdminServer) GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
+func (*UnimplementedRaftInspectServer) GetStableStore(context.Context, *GetStableStoreRequest) (*GetStableStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStableStore not implemented")
 }
-func (*UnimplementedRaftAdminServer) LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error) {
+func (*UnimplementedRaftInspectServer) LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastContact not implemented")
 }
-func (*UnimplementedRaftAdminServer) LastIndex(context.Context, *LastIndexRequest) (*LastIndexResponse, error) {
+func (*UnimplementedRaftInspectServer) LastIndex(context.Context, *LastIndexRequest) (*LastIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastIndex not implemented")
 }
-func (*UnimplementedRaftAdminServer) Leader(context.Context, *LeaderRequest) (*LeaderResponse, error) {
+func (*UnimplementedRaftInspectServer) LastSnapshot(context.Context, *LastSnapshotRequest) (*LastSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSnapshot not implemented")
+}
+func (*UnimplementedRaftInspectServer) Leader(context.Context, *LeaderRequest) (*LeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Leader not implemented")
 }
-func (*UnimplementedRaftAdminServer) LeadershipTransfer(context.Context, *LeadershipTransferRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeadershipTransfer not implemented")
+func (*UnimplementedRaftInspectServer) ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
 }
-func (*UnimplementedRaftAdminServer) LeadershipTransferToServer(context.Context, *LeadershipTransferToServerRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeadershipTransferToServer not implemented")
+func (*UnimplementedRaftInspectServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
 }
-func (*UnimplementedRaftAdminServer) RemoveServer(context.Context, *RemoveServerRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveServer not implemented")
+func (*UnimplementedRaftInspectServer) ListPendingFutures(context.Context, *ListPendingFuturesRequest) (*ListPendingFuturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingFutures not implemented")
 }
-func (*UnimplementedRaftAdminServer) Shutdown(context.Context, *ShutdownRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+func (*UnimplementedRaftInspectServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
 }
-func (*UnimplementedRaftAdminServer) Snapshot(context.Context, *SnapshotRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+func (*UnimplementedRaftInspectServer) LogStoreStats(context.Context, *LogStoreStatsRequest) (*LogStoreStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogStoreStats not implemented")
 }
-func (*UnimplementedRaftAdminServer) State(context.Context, *StateRequest) (*StateResponse, error) {
+func (*UnimplementedRaftInspectServer) Observe(*ObserveRequest, RaftInspect_ObserveServer) error {
+	return status.Errorf(codes.Unimplemented, "method Observe not implemented")
+}
+func (*UnimplementedRaftInspectServer) PeerLastContact(context.Context, *PeerLastContactRequest) (*PeerLastContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeerLastContact not implemented")
+}
+func (*UnimplementedRaftInspectServer) QuorumStatus(context.Context, *QuorumStatusRequest) (*QuorumStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuorumStatus not implemented")
+}
+func (*UnimplementedRaftInspectServer) ReplicationStatus(context.Context, *ReplicationStatusRequest) (*ReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicationStatus not implemented")
+}
+func (*UnimplementedRaftInspectServer) ServerHealth(context.Context, *ServerHealthRequest) (*ServerHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerHealth not implemented")
+}
+func (*UnimplementedRaftInspectServer) ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
+}
+func (*UnimplementedRaftInspectServer) State(context.Context, *StateRequest) (*StateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
 }
-func (*UnimplementedRaftAdminServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+func (*UnimplementedRaftInspectServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
 }
-func (*UnimplementedRaftAdminServer) VerifyLeader(context.Context, *VerifyLeaderRequest) (*Future, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyLeader not implemented")
+func (*UnimplementedRaftInspectServer) StatsHistory(context.Context, *StatsHistoryRequest) (*StatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsHistory not implemented")
+}
+func (*UnimplementedRaftInspectServer) VerifyFSM(context.Context, *VerifyFSMRequest) (*VerifyFSMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyFSM not implemented")
+}
+func (*UnimplementedRaftInspectServer) WaitForIndex(context.Context, *WaitForIndexRequest) (*WaitForIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForIndex not implemented")
+}
+func (*UnimplementedRaftInspectServer) WatchAppliedIndex(*WatchAppliedIndexRequest, RaftInspect_WatchAppliedIndexServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAppliedIndex not implemented")
+}
+func (*UnimplementedRaftInspectServer) WatchConfiguration(*WatchConfigurationRequest, RaftInspect_WatchConfigurationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfiguration not implemented")
+}
+func (*UnimplementedRaftInspectServer) WatchLeader(*WatchLeaderRequest, RaftInspect_WatchLeaderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLeader not implemented")
+}
+func (*UnimplementedRaftInspectServer) WatchState(*WatchStateRequest, RaftInspect_WatchStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchState not implemented")
+}
+
+func RegisterRaftInspectServer(s *grpc.Server, srv RaftInspectServer) {
+	s.RegisterService(&_RaftInspect_serviceDesc, srv)
+}
+
+func _RaftInspect_AppliedIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppliedIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftInspectServer).AppliedIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftInspect/AppliedIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftInspectServer).AppliedIndex(ctx, req.(*AppliedIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftInspect_ClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftInspectServer).ClusterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftInspect/ClusterInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftInspectServer).ClusterInfo(ctx, req.(*ClusterInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftInspect_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftInspectServer).GetConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftInspect/GetConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftInspectServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRaftAdminServer) Await(context.Context, *Future) (*AwaitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Await not implemented")
+
+func _RaftInspect_GetIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftInspectServer).GetIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftInspect/GetIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftInspectServer).GetIndexes(ctx, req.(*GetIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRaftAdminServer) Forget(context.Context, *Future) (*ForgetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Forget not implemented")
+
+func _RaftInspect_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftInspectServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftInspect/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftInspectServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRaftAdminServer(s *grpc.Server, srv RaftAdminServer) {
-	s.RegisterService(&_RaftAdmin_serviceDesc, srv)
+func _RaftInspect_GetReloadableConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReloadableConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftInspectServer).GetReloadableConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftInspect/GetReloadableConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftInspectServer).GetReloadableConfig(ctx, req.(*GetReloadableConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_AddNonvoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddNonvoterRequest)
+func _RaftInspect_GetStableStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStableStoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).AddNonvoter(ctx, in)
+		return srv.(RaftInspectServer).GetStableStore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/AddNonvoter",
+		FullMethod: "/RaftInspect/GetStableStore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).AddNonvoter(ctx, req.(*AddNonvoterRequest))
+		return srv.(RaftInspectServer).GetStableStore(ctx, req.(*GetStableStoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_AddVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVoterRequest)
+func _RaftInspect_LastContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastContactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).AddVoter(ctx, in)
+		return srv.(RaftInspectServer).LastContact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/AddVoter",
+		FullMethod: "/RaftInspect/LastContact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).AddVoter(ctx, req.(*AddVoterRequest))
+		return srv.(RaftInspectServer).LastContact(ctx, req.(*LastContactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_AppliedIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppliedIndexRequest)
+func _RaftInspect_LastIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).AppliedIndex(ctx, in)
+		return srv.(RaftInspectServer).LastIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/AppliedIndex",
+		FullMethod: "/RaftInspect/LastIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).AppliedIndex(ctx, req.(*AppliedIndexRequest))
+		return srv.(RaftInspectServer).LastIndex(ctx, req.(*LastIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_ApplyLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyLogRequest)
+func _RaftInspect_LastSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).ApplyLog(ctx, in)
+		return srv.(RaftInspectServer).LastSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/ApplyLog",
+		FullMethod: "/RaftInspect/LastSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).ApplyLog(ctx, req.(*ApplyLogRequest))
+		return srv.(RaftInspectServer).LastSnapshot(ctx, req.(*LastSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_Barrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BarrierRequest)
+func _RaftInspect_Leader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).Barrier(ctx, in)
+		return srv.(RaftInspectServer).Leader(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/Barrier",
+		FullMethod: "/RaftInspect/Leader",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).Barrier(ctx, req.(*BarrierRequest))
+		return srv.(RaftInspectServer).Leader(ctx, req.(*LeaderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_DemoteVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DemoteVoterRequest)
+func _RaftInspect_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).DemoteVoter(ctx, in)
+		return srv.(RaftInspectServer).ListClusters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/DemoteVoter",
+		FullMethod: "/RaftInspect/ListClusters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).DemoteVoter(ctx, req.(*DemoteVoterRequest))
+		return srv.(RaftInspectServer).ListClusters(ctx, req.(*ListClustersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigurationRequest)
+func _RaftInspect_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).GetConfiguration(ctx, in)
+		return srv.(RaftInspectServer).ListOperations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/GetConfiguration",
+		FullMethod: "/RaftInspect/ListOperations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+		return srv.(RaftInspectServer).ListOperations(ctx, req.(*ListOperationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_LastContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastContactRequest)
+func _RaftInspect_ListPendingFutures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingFuturesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).LastContact(ctx, in)
+		return srv.(RaftInspectServer).ListPendingFutures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/LastContact",
+		FullMethod: "/RaftInspect/ListPendingFutures",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).LastContact(ctx, req.(*LastContactRequest))
+		return srv.(RaftInspectServer).ListPendingFutures(ctx, req.(*ListPendingFuturesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_LastIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastIndexRequest)
+func _RaftInspect_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).LastIndex(ctx, in)
+		return srv.(RaftInspectServer).ListSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/LastIndex",
+		FullMethod: "/RaftInspect/ListSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).LastIndex(ctx, req.(*LastIndexRequest))
+		return srv.(RaftInspectServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_Leader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaderRequest)
+func _RaftInspect_LogStoreStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStoreStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).Leader(ctx, in)
+		return srv.(RaftInspectServer).LogStoreStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/Leader",
+		FullMethod: "/RaftInspect/LogStoreStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).Leader(ctx, req.(*LeaderRequest))
+		return srv.(RaftInspectServer).LogStoreStats(ctx, req.(*LogStoreStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_LeadershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeadershipTransferRequest)
+func _RaftInspect_Observe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftInspectServer).Observe(m, &raftInspectObserveServer{stream})
+}
+
+type RaftInspect_ObserveServer interface {
+	Send(*Observation) error
+	grpc.ServerStream
+}
+
+type raftInspectObserveServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftInspectObserveServer) Send(m *Observation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftInspect_PeerLastContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerLastContactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).LeadershipTransfer(ctx, in)
+		return srv.(RaftInspectServer).PeerLastContact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/LeadershipTransfer",
+		FullMethod: "/RaftInspect/PeerLastContact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).LeadershipTransfer(ctx, req.(*LeadershipTransferRequest))
+		return srv.(RaftInspectServer).PeerLastContact(ctx, req.(*PeerLastContactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_LeadershipTransferToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeadershipTransferToServerRequest)
+func _RaftInspect_QuorumStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuorumStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).LeadershipTransferToServer(ctx, in)
+		return srv.(RaftInspectServer).QuorumStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/LeadershipTransferToServer",
+		FullMethod: "/RaftInspect/QuorumStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).LeadershipTransferToServer(ctx, req.(*LeadershipTransferToServerRequest))
+		return srv.(RaftInspectServer).QuorumStatus(ctx, req.(*QuorumStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_RemoveServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveServerRequest)
+func _RaftInspect_ReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicationStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).RemoveServer(ctx, in)
+		return srv.(RaftInspectServer).ReplicationStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/RemoveServer",
+		FullMethod: "/RaftInspect/ReplicationStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).RemoveServer(ctx, req.(*RemoveServerRequest))
+		return srv.(RaftInspectServer).ReplicationStatus(ctx, req.(*ReplicationStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShutdownRequest)
+func _RaftInspect_ServerHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).Shutdown(ctx, in)
+		return srv.(RaftInspectServer).ServerHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/Shutdown",
+		FullMethod: "/RaftInspect/ServerHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).Shutdown(ctx, req.(*ShutdownRequest))
+		return srv.(RaftInspectServer).ServerHealth(ctx, req.(*ServerHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SnapshotRequest)
+func _RaftInspect_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).Snapshot(ctx, in)
+		return srv.(RaftInspectServer).ServerInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/Snapshot",
+		FullMethod: "/RaftInspect/ServerInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).Snapshot(ctx, req.(*SnapshotRequest))
+		return srv.(RaftInspectServer).ServerInfo(ctx, req.(*ServerInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _RaftInspect_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).State(ctx, in)
+		return srv.(RaftInspectServer).State(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/State",
+		FullMethod: "/RaftInspect/State",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).State(ctx, req.(*StateRequest))
+		return srv.(RaftInspectServer).State(ctx, req.(*StateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _RaftInspect_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).Stats(ctx, in)
+		return srv.(RaftInspectServer).Stats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/Stats",
+		FullMethod: "/RaftInspect/Stats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).Stats(ctx, req.(*StatsRequest))
+		return srv.(RaftInspectServer).Stats(ctx, req.(*StatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_VerifyLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyLeaderRequest)
+func _RaftInspect_StatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).VerifyLeader(ctx, in)
+		return srv.(RaftInspectServer).StatsHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/VerifyLeader",
+		FullMethod: "/RaftInspect/StatsHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).VerifyLeader(ctx, req.(*VerifyLeaderRequest))
+		return srv.(RaftInspectServer).StatsHistory(ctx, req.(*StatsHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_Await_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Future)
+func _RaftInspect_VerifyFSM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyFSMRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).Await(ctx, in)
+		return srv.(RaftInspectServer).VerifyFSM(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/Await",
+		FullMethod: "/RaftInspect/VerifyFSM",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).Await(ctx, req.(*Future))
+		return srv.(RaftInspectServer).VerifyFSM(ctx, req.(*VerifyFSMRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RaftAdmin_Forget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Future)
+func _RaftInspect_WaitForIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RaftAdminServer).Forget(ctx, in)
+		return srv.(RaftInspectServer).WaitForIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/RaftAdmin/Forget",
+		FullMethod: "/RaftInspect/WaitForIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftAdminServer).Forget(ctx, req.(*Future))
+		return srv.(RaftInspectServer).WaitForIndex(ctx, req.(*WaitForIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _RaftAdmin_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "RaftAdmin",
-	HandlerType: (*RaftAdminServer)(nil),
+func _RaftInspect_WatchAppliedIndex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAppliedIndexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftInspectServer).WatchAppliedIndex(m, &raftInspectWatchAppliedIndexServer{stream})
+}
+
+type RaftInspect_WatchAppliedIndexServer interface {
+	Send(*AppliedIndexResponse) error
+	grpc.ServerStream
+}
+
+type raftInspectWatchAppliedIndexServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftInspectWatchAppliedIndexServer) Send(m *AppliedIndexResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftInspect_WatchConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigurationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftInspectServer).WatchConfiguration(m, &raftInspectWatchConfigurationServer{stream})
+}
+
+type RaftInspect_WatchConfigurationServer interface {
+	Send(*GetConfigurationResponse) error
+	grpc.ServerStream
+}
+
+type raftInspectWatchConfigurationServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftInspectWatchConfigurationServer) Send(m *GetConfigurationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftInspect_WatchLeader_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLeaderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftInspectServer).WatchLeader(m, &raftInspectWatchLeaderServer{stream})
+}
+
+type RaftInspect_WatchLeaderServer interface {
+	Send(*LeaderResponse) error
+	grpc.ServerStream
+}
+
+type raftInspectWatchLeaderServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftInspectWatchLeaderServer) Send(m *LeaderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RaftInspect_WatchState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaftInspectServer).WatchState(m, &raftInspectWatchStateServer{stream})
+}
+
+type RaftInspect_WatchStateServer interface {
+	Send(*StateResponse) error
+	grpc.ServerStream
+}
+
+type raftInspectWatchStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftInspectWatchStateServer) Send(m *StateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _RaftInspect_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "RaftInspect",
+	HandlerType: (*RaftInspectServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "AddNonvoter",
-			Handler:    _RaftAdmin_AddNonvoter_Handler,
+			MethodName: "AppliedIndex",
+			Handler:    _RaftInspect_AppliedIndex_Handler,
 		},
 		{
-			MethodName: "AddVoter",
-			Handler:    _RaftAdmin_AddVoter_Handler,
+			MethodName: "ClusterInfo",
+			Handler:    _RaftInspect_ClusterInfo_Handler,
 		},
 		{
-			MethodName: "AppliedIndex",
-			Handler:    _RaftAdmin_AppliedIndex_Handler,
+			MethodName: "GetConfiguration",
+			Handler:    _RaftInspect_GetConfiguration_Handler,
 		},
 		{
-			MethodName: "ApplyLog",
-			Handler:    _RaftAdmin_ApplyLog_Handler,
+			MethodName: "GetIndexes",
+			Handler:    _RaftInspect_GetIndexes_Handler,
 		},
 		{
-			MethodName: "Barrier",
-			Handler:    _RaftAdmin_Barrier_Handler,
+			MethodName: "GetMetadata",
+			Handler:    _RaftInspect_GetMetadata_Handler,
 		},
 		{
-			MethodName: "DemoteVoter",
-			Handler:    _RaftAdmin_DemoteVoter_Handler,
+			MethodName: "GetReloadableConfig",
+			Handler:    _RaftInspect_GetReloadableConfig_Handler,
 		},
 		{
-			MethodName: "GetConfiguration",
-			Handler:    _RaftAdmin_GetConfiguration_Handler,
+			MethodName: "GetStableStore",
+			Handler:    _RaftInspect_GetStableStore_Handler,
 		},
 		{
 			MethodName: "LastContact",
-			Handler:    _RaftAdmin_LastContact_Handler,
+			Handler:    _RaftInspect_LastContact_Handler,
 		},
 		{
 			MethodName: "LastIndex",
-			Handler:    _RaftAdmin_LastIndex_Handler,
+			Handler:    _RaftInspect_LastIndex_Handler,
+		},
+		{
+			MethodName: "LastSnapshot",
+			Handler:    _RaftInspect_LastSnapshot_Handler,
 		},
 		{
 			MethodName: "Leader",
-			Handler:    _RaftAdmin_Leader_Handler,
+			Handler:    _RaftInspect_Leader_Handler,
 		},
 		{
-			MethodName: "LeadershipTransfer",
-			Handler:    _RaftAdmin_LeadershipTransfer_Handler,
+			MethodName: "ListClusters",
+			Handler:    _RaftInspect_ListClusters_Handler,
 		},
 		{
-			MethodName: "LeadershipTransferToServer",
-			Handler:    _RaftAdmin_LeadershipTransferToServer_Handler,
+			MethodName: "ListOperations",
+			Handler:    _RaftInspect_ListOperations_Handler,
 		},
 		{
-			MethodName: "RemoveServer",
-			Handler:    _RaftAdmin_RemoveServer_Handler,
+			MethodName: "ListPendingFutures",
+			Handler:    _RaftInspect_ListPendingFutures_Handler,
 		},
 		{
-			MethodName: "Shutdown",
-			Handler:    _RaftAdmin_Shutdown_Handler,
+			MethodName: "ListSnapshots",
+			Handler:    _RaftInspect_ListSnapshots_Handler,
 		},
 		{
-			MethodName: "Snapshot",
-			Handler:    _RaftAdmin_Snapshot_Handler,
+			MethodName: "LogStoreStats",
+			Handler:    _RaftInspect_LogStoreStats_Handler,
+		},
+		{
+			MethodName: "PeerLastContact",
+			Handler:    _RaftInspect_PeerLastContact_Handler,
+		},
+		{
+			MethodName: "QuorumStatus",
+			Handler:    _RaftInspect_QuorumStatus_Handler,
+		},
+		{
+			MethodName: "ReplicationStatus",
+			Handler:    _RaftInspect_ReplicationStatus_Handler,
+		},
+		{
+			MethodName: "ServerHealth",
+			Handler:    _RaftInspect_ServerHealth_Handler,
+		},
+		{
+			MethodName: "ServerInfo",
+			Handler:    _RaftInspect_ServerInfo_Handler,
 		},
 		{
 			MethodName: "State",
-			Handler:    _RaftAdmin_State_Handler,
+			Handler:    _RaftInspect_State_Handler,
 		},
 		{
 			MethodName: "Stats",
-			Handler:    _RaftAdmin_Stats_Handler,
+			Handler:    _RaftInspect_Stats_Handler,
 		},
 		{
-			MethodName: "VerifyLeader",
-			Handler:    _RaftAdmin_VerifyLeader_Handler,
+			MethodName: "StatsHistory",
+			Handler:    _RaftInspect_StatsHistory_Handler,
 		},
 		{
-			MethodName: "Await",
-			Handler:    _RaftAdmin_Await_Handler,
+			MethodName: "VerifyFSM",
+			Handler:    _RaftInspect_VerifyFSM_Handler,
 		},
 		{
-			MethodName: "Forget",
-			Handler:    _RaftAdmin_Forget_Handler,
+			MethodName: "WaitForIndex",
+			Handler:    _RaftInspect_WaitForIndex_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Observe",
+			Handler:       _RaftInspect_Observe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchAppliedIndex",
+			Handler:       _RaftInspect_WatchAppliedIndex_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchConfiguration",
+			Handler:       _RaftInspect_WatchConfiguration_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchLeader",
+			Handler:       _RaftInspect_WatchLeader_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchState",
+			Handler:       _RaftInspect_WatchState_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "raftadmin.proto",
 }
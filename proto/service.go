@@ -0,0 +1,101 @@
+package proto
+
+import (
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpc "google.golang.org/grpc"
+	status "google.golang.org/grpc/status"
+)
+
+// AwaitMetadataKey is the metadata key that makes RPCs that start an operation wait for it, when set to "true". The result is returned in FutureResponse.Result, in a single round trip.
+// If the call's deadline expires first, it fails and the operation continues, like when an Await is cancelled. The error carries the operation token, so the client can still Await or Forget it; see OperationTokenFromError.
+const AwaitMetadataKey = "raftadmin-await"
+
+// ExtensionServiceName is the name of the service that serves the application-specific methods added with raftadmin.WithExtension, on the same server as RaftAdmin. Its descriptor is available through gRPC reflection.
+const ExtensionServiceName = "RaftAdminExtensions"
+
+// ClusterMetadataKey is the metadata key that holds the ID of the raft group a call is for, on servers that host several with raftadmin.Clusters.
+const ClusterMetadataKey = "raftadmin-cluster"
+
+const (
+	// ErrorDomain is the domain of the google.rpc.ErrorInfo details the server attaches to errors.
+	ErrorDomain = "raftadmin"
+	// NotLeaderReason is the reason in the ErrorInfo of errors for calls that must be sent to the leader. Its metadata holds the leader_id and leader_address, which are empty if there is no leader.
+	NotLeaderReason = "NOT_LEADER"
+	// ConfirmationRequiredReason is the reason in the ErrorInfo of errors for calls that must be confirmed, see raftadmin.WithConfirmation. Its metadata holds the confirmation_token and a description of the effect of the call.
+	ConfirmationRequiredReason = "CONFIRMATION_REQUIRED"
+	// OperationPendingReason is the reason in the ErrorInfo of errors for calls with AwaitMetadataKey that started an operation but failed waiting for it. Its metadata holds the operation_token.
+	OperationPendingReason = "OPERATION_PENDING"
+)
+
+// Future returns the Future of the operation r is about, to pass to Await and Forget.
+func (r *FutureResponse) Future() *Future {
+	return &Future{OperationToken: r.GetOperationToken()}
+}
+
+// LeaderFromError returns the ID and address of the leader if err says the call must be sent to the leader.
+func LeaderFromError(err error) (id, address string, ok bool) {
+	st, _ := status.FromError(err)
+	for _, d := range st.Details() {
+		if ei, isInfo := d.(*errdetails.ErrorInfo); isInfo && ei.GetDomain() == ErrorDomain && ei.GetReason() == NotLeaderReason {
+			return ei.GetMetadata()["leader_id"], ei.GetMetadata()["leader_address"], true
+		}
+	}
+	return "", "", false
+}
+
+// ConfirmationFromError returns the confirmation token and the description of what the call will do if err says the call must be confirmed by sending it again with the token.
+func ConfirmationFromError(err error) (token, effect string, ok bool) {
+	st, _ := status.FromError(err)
+	for _, d := range st.Details() {
+		if ei, isInfo := d.(*errdetails.ErrorInfo); isInfo && ei.GetDomain() == ErrorDomain && ei.GetReason() == ConfirmationRequiredReason {
+			return ei.GetMetadata()["confirmation_token"], ei.GetMetadata()["effect"], true
+		}
+	}
+	return "", "", false
+}
+
+// OperationTokenFromError returns the token of the operation that was started if err says the call failed while waiting for it, see AwaitMetadataKey.
+func OperationTokenFromError(err error) (token string, ok bool) {
+	st, _ := status.FromError(err)
+	for _, d := range st.Details() {
+		if ei, isInfo := d.(*errdetails.ErrorInfo); isInfo && ei.GetDomain() == ErrorDomain && ei.GetReason() == OperationPendingReason {
+			return ei.GetMetadata()["operation_token"], true
+		}
+	}
+	return "", false
+}
+
+// RaftAdminServiceDesc returns a copy of the grpc.ServiceDesc of the RaftAdmin service, so the server can wrap its handlers.
+func RaftAdminServiceDesc() grpc.ServiceDesc {
+	d := _RaftAdmin_serviceDesc
+	d.Methods = append([]grpc.MethodDesc{}, d.Methods...)
+	d.Streams = append([]grpc.StreamDesc{}, d.Streams...)
+	return d
+}
+
+// RaftInspectServiceDesc returns a grpc.ServiceDesc of the RaftInspect service whose handlers call a RaftAdminServer, like those of RaftAdminServiceDesc, so one implementation can serve both services.
+func RaftInspectServiceDesc() grpc.ServiceDesc {
+	inspect := map[string]bool{}
+	for _, m := range _RaftInspect_serviceDesc.Methods {
+		inspect[m.MethodName] = true
+	}
+	for _, s := range _RaftInspect_serviceDesc.Streams {
+		inspect[s.StreamName] = true
+	}
+	d := RaftAdminServiceDesc()
+	d.ServiceName = _RaftInspect_serviceDesc.ServiceName
+	var methods []grpc.MethodDesc
+	for _, m := range d.Methods {
+		if inspect[m.MethodName] {
+			methods = append(methods, m)
+		}
+	}
+	var streams []grpc.StreamDesc
+	for _, s := range d.Streams {
+		if inspect[s.StreamName] {
+			streams = append(streams, s)
+		}
+	}
+	d.Methods, d.Streams = methods, streams
+	return d
+}
@@ -0,0 +1,63 @@
+package raftadmin
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkQuorum checks the effect on the voters of making server id a voter (vote is true) or taking away its vote, for WithQuorumChecks.
+// It fails with FAILED_PRECONDITION if fewer voters than needed for quorum would be reachable, unless force is set. It returns warnings for changes that go through anyway, like those that leave an even number of voters.
+// Only the leader knows which voters are reachable, so followers skip the checks and leave it to raft to fail the call.
+func (a *admin) checkQuorum(method string, id raft.ServerID, vote, force bool) ([]string, error) {
+	if !a.quorumChecks || a.r.State() != raft.Leader {
+		return nil, nil
+	}
+	cf := a.r.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, err
+	}
+	_, self := a.r.LeaderWithID()
+	voters, reachable := 0, 0
+	isVoter, isReachable := false, false
+	for _, s := range cf.Configuration().Servers {
+		if s.Suffrage != raft.Voter {
+			continue
+		}
+		voters++
+		_, up := a.heartbeats.stableSince(s.ID)
+		up = up || s.ID == self
+		if up {
+			reachable++
+		}
+		if s.ID == id {
+			isVoter, isReachable = true, up
+		}
+	}
+	if isVoter == vote {
+		return nil, nil
+	}
+	if vote {
+		// The leader hasn't heard from a new voter yet, so it doesn't count as reachable.
+		voters++
+	} else {
+		voters--
+		if isReachable {
+			reachable--
+		}
+	}
+	var warnings []string
+	if q := quorumSize(voters); reachable < q {
+		msg := fmt.Sprintf("%s of %s would leave %d voters of which %d are reachable, fewer than the %d needed for quorum", method, id, voters, reachable, q)
+		if !force {
+			return nil, status.Error(codes.FailedPrecondition, msg+"; set force to do it anyway")
+		}
+		warnings = append(warnings, msg)
+	}
+	if voters%2 == 0 && voters > 0 {
+		warnings = append(warnings, fmt.Sprintf("%s of %s leaves an even number of voters (%d), which survives no more failures than %d voters", method, id, voters, voters-1))
+	}
+	return warnings, nil
+}
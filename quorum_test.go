@@ -0,0 +1,41 @@
+package raftadmin
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+)
+
+func TestQuorumChecks(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	leader := bootstrap(t, nodes[0])
+	s := newTestServer(t, leader.r, WithQuorumChecks())
+	ctx := context.Background()
+
+	// The leader hasn't heard from the new voter yet, so it would be the only reachable one of two.
+	req := &pb.AddVoterRequest{Id: string(nodes[1].id), Address: string(nodes[1].addr)}
+	_, err := s.a.AddVoter(ctx, req)
+	wantCode(t, err, codes.FailedPrecondition, "fewer than the 2 needed for quorum")
+	if hasServer(t, leader.r, nodes[1].id) {
+		t.Fatalf("%s was added without force", nodes[1].id)
+	}
+
+	req.Force = true
+	f, err := s.a.AddVoter(ctx, req)
+	if err != nil {
+		t.Fatalf("AddVoter with force failed: %v", err)
+	}
+	if len(f.GetWarnings()) != 2 {
+		t.Errorf("AddVoter with force returned warnings %q, want one about quorum and one about the even number of voters", f.GetWarnings())
+	}
+	awaitOK(t, s.a, f, nil)
+
+	// Both voters are reachable, so going back to one is fine.
+	f, err = s.a.RemoveServer(ctx, &pb.RemoveServerRequest{Id: string(nodes[1].id)})
+	awaitOK(t, s.a, f, err)
+	if len(f.GetWarnings()) != 0 {
+		t.Errorf("RemoveServer returned warnings %q", f.GetWarnings())
+	}
+}
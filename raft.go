@@ -0,0 +1,39 @@
+package raftadmin
+
+import (
+	"io"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// Raft is the part of *raft.Raft that the server uses. Pass something else than a *raft.Raft to New to wrap it, for example to add metrics or to pick a raft group per call, or to use a fake in tests.
+// The server can't ask other implementations for the address of this node, so pass it with WithLocalAddress.
+type Raft interface {
+	AddNonvoter(id raft.ServerID, address raft.ServerAddress, prevIndex uint64, timeout time.Duration) raft.IndexFuture
+	AddVoter(id raft.ServerID, address raft.ServerAddress, prevIndex uint64, timeout time.Duration) raft.IndexFuture
+	AppliedIndex() uint64
+	ApplyLog(log raft.Log, timeout time.Duration) raft.ApplyFuture
+	Barrier(timeout time.Duration) raft.Future
+	BootstrapCluster(configuration raft.Configuration) raft.Future
+	DemoteVoter(id raft.ServerID, prevIndex uint64, timeout time.Duration) raft.IndexFuture
+	DeregisterObserver(or *raft.Observer)
+	GetConfiguration() raft.ConfigurationFuture
+	LastContact() time.Time
+	LastIndex() uint64
+	LeaderWithID() (raft.ServerAddress, raft.ServerID)
+	LeadershipTransfer() raft.Future
+	LeadershipTransferToServer(id raft.ServerID, address raft.ServerAddress) raft.Future
+	RegisterObserver(or *raft.Observer)
+	ReloadConfig(rc raft.ReloadableConfig) error
+	ReloadableConfig() raft.ReloadableConfig
+	RemoveServer(id raft.ServerID, prevIndex uint64, timeout time.Duration) raft.IndexFuture
+	Restore(meta *raft.SnapshotMeta, reader io.Reader, timeout time.Duration) error
+	Shutdown() raft.Future
+	Snapshot() raft.SnapshotFuture
+	State() raft.RaftState
+	Stats() map[string]string
+	VerifyLeader() raft.Future
+}
+
+var _ Raft = (*raft.Raft)(nil)
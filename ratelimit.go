@@ -0,0 +1,32 @@
+package raftadmin
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rateLimit allows one call per interval to any of its methods. See WithRateLimit.
+type rateLimit struct {
+	interval time.Duration
+	methods  map[string]bool
+
+	mtx  sync.Mutex
+	last time.Time
+}
+
+// allow returns an error if method is limited by l and the previous call to one of its methods was less than interval ago. Otherwise it counts the call.
+func (l *rateLimit) allow(method string) error {
+	if !l.methods[method] {
+		return nil
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if wait := l.interval - time.Since(l.last); wait > 0 {
+		return status.Errorf(codes.ResourceExhausted, "%s is rate limited to one call per %s; try again in %s", method, l.interval, wait.Round(time.Millisecond))
+	}
+	l.last = time.Now()
+	return nil
+}
@@ -0,0 +1,54 @@
+package raftadmin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRateLimit(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	leader := bootstrap(t, nodes...)
+	ctx := context.Background()
+
+	reject := true
+	hook := func(ctx context.Context, method string, req proto.Message) error {
+		if reject {
+			return errors.New("rejected by hook")
+		}
+		return nil
+	}
+	c := newTestClient(t, newTestServer(t, leader.r, WithHook(hook), WithRateLimit(time.Hour, "Barrier")))
+	for i := 0; i < 2; i++ {
+		_, err := c.Barrier(ctx, &pb.BarrierRequest{})
+		wantCode(t, err, codes.Unknown, "rejected by hook")
+	}
+	// The calls rejected by the hook didn't use up the budget.
+	reject = false
+	f, err := c.Barrier(ctx, &pb.BarrierRequest{})
+	if err != nil {
+		t.Fatalf("Barrier failed: %v", err)
+	}
+	if _, err := c.Forget(ctx, f.Future()); err != nil {
+		t.Fatalf("Forget failed: %v", err)
+	}
+	_, err = c.Barrier(ctx, &pb.BarrierRequest{})
+	wantCode(t, err, codes.ResourceExhausted, "rate limited")
+	if _, err := c.State(ctx, &pb.StateRequest{}); err != nil {
+		t.Errorf("State isn't limited, but failed: %v", err)
+	}
+}
+
+func TestRateLimitNeedsPositiveInterval(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithRateLimit(0) didn't panic")
+		}
+	}()
+	Get(nil, WithRateLimit(0, "Barrier"))
+}
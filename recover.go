@@ -0,0 +1,74 @@
+package raftadmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/raft"
+)
+
+// peersEntry is an entry in a peers.json file, as read by raft.ReadConfigJSON.
+type peersEntry struct {
+	ID       raft.ServerID      `json:"id"`
+	Address  raft.ServerAddress `json:"address"`
+	NonVoter bool               `json:"non_voter"`
+}
+
+// writeRecoveryFile validates the configuration and atomically writes it to path in the peers.json format.
+func writeRecoveryFile(path string, c raft.Configuration) error {
+	var peers []peersEntry
+	voters := 0
+	ids := map[raft.ServerID]bool{}
+	addrs := map[raft.ServerAddress]bool{}
+	for _, s := range c.Servers {
+		if s.ID == "" || s.Address == "" {
+			return fmt.Errorf("servers need both an ID and an address")
+		}
+		if ids[s.ID] || addrs[s.Address] {
+			return fmt.Errorf("duplicate server %s (%s)", s.ID, s.Address)
+		}
+		ids[s.ID] = true
+		addrs[s.Address] = true
+		switch s.Suffrage {
+		case raft.Voter:
+			voters++
+		case raft.Nonvoter:
+		default:
+			return fmt.Errorf("server %s has suffrage %v; only voters and nonvoters can be recovered", s.ID, s.Suffrage)
+		}
+		peers = append(peers, peersEntry{s.ID, s.Address, s.Suffrage == raft.Nonvoter})
+	}
+	if voters == 0 {
+		return fmt.Errorf("the configuration needs at least one voter")
+	}
+	b, err := json.MarshalIndent(peers, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// RecoverFromFile applies a configuration staged by StageRecovery (or a hand-written peers.json) with raft.RecoverCluster, and then removes the file.
+// It does nothing if path doesn't exist. Call it before raft.NewRaft with the same arguments you pass to NewRaft.
+// It returns whether a recovery was done.
+func RecoverFromFile(path string, conf *raft.Config, fsm raft.FSM, logs raft.LogStore, stable raft.StableStore, snaps raft.SnapshotStore, trans raft.Transport) (bool, error) {
+	c, err := raft.ReadConfigJSON(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read recovery configuration from %s: %v", path, err)
+	}
+	if err := raft.RecoverCluster(conf, fsm, logs, stable, snaps, trans, c); err != nil {
+		return false, fmt.Errorf("failed to recover cluster with configuration from %s: %v", path, err)
+	}
+	if err := os.Remove(path); err != nil {
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,118 @@
+package raftadmin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// peer returns a client for the RaftAdmin service of another node, reusing connections.
+func (a *admin) peer(addr raft.ServerAddress) (pb.RaftAdminClient, error) {
+	c, err := a.peerConn(addr)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewRaftAdminClient(c), nil
+}
+
+// peerConn returns a connection to the node at addr, reusing an earlier one if possible.
+func (a *admin) peerConn(addr raft.ServerAddress) (*grpc.ClientConn, error) {
+	a.peerMtx.Lock()
+	defer a.peerMtx.Unlock()
+	if c, ok := a.peerConns[addr]; ok {
+		return c, nil
+	}
+	opts := a.peerDialOptions
+	if a.clusterID != "" {
+		// Make sure calls end up at the same raft group on the other node.
+		opts = append(opts[:len(opts):len(opts)], grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(metadata.AppendToOutgoingContext(ctx, pb.ClusterMetadataKey, a.clusterID), method, req, reply, cc, opts...)
+		}))
+	}
+	c, err := grpc.Dial(string(addr), opts...)
+	if err != nil {
+		return nil, err
+	}
+	if a.peerConns == nil {
+		a.peerConns = map[raft.ServerAddress]*grpc.ClientConn{}
+	}
+	a.peerConns[addr] = c
+	return c, nil
+}
+
+func (a *admin) ReplicationStatus(ctx context.Context, req *pb.ReplicationStatusRequest) (*pb.ReplicationStatusResponse, error) {
+	if a.peerDialOptions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ReplicationStatus requires raftadmin.WithPeerDialOptions")
+	}
+	if a.r.State() != raft.Leader {
+		return nil, a.notLeaderError("ReplicationStatus")
+	}
+	cf := a.r.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, err
+	}
+	stats := a.r.Stats()
+	ret := &pb.ReplicationStatusResponse{}
+	var err error
+	if ret.LastIndex, err = strconv.ParseUint(stats["last_log_index"], 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to parse last_log_index: %v", err)
+	}
+	if ret.CommitIndex, err = strconv.ParseUint(stats["commit_index"], 10, 64); err != nil {
+		return nil, fmt.Errorf("failed to parse commit_index: %v", err)
+	}
+
+	_, self := a.r.LeaderWithID()
+	var wg sync.WaitGroup
+	for _, s := range cf.Configuration().Servers {
+		if s.ID == self {
+			continue
+		}
+		f := &pb.ReplicationStatusResponse_Follower{
+			Id:      string(s.ID),
+			Address: string(s.Address),
+		}
+		if f.Suffrage, err = toSuffrage(s); err != nil {
+			return nil, err
+		}
+		ret.Followers = append(ret.Followers, f)
+		wg.Add(1)
+		go func(s raft.Server, f *pb.ReplicationStatusResponse_Follower) {
+			defer wg.Done()
+			if err := a.fillFollower(ctx, s, f, ret.LastIndex); err != nil {
+				f.Error = err.Error()
+			}
+		}(s, f)
+	}
+	wg.Wait()
+	return ret, nil
+}
+
+// fillFollower asks a follower for its Stats and fills in its replication status.
+func (a *admin) fillFollower(ctx context.Context, s raft.Server, f *pb.ReplicationStatusResponse_Follower, lastIndex uint64) error {
+	c, err := a.peer(s.Address)
+	if err != nil {
+		return err
+	}
+	st, err := c.Stats(ctx, &pb.StatsRequest{})
+	if err != nil {
+		return err
+	}
+	f.MatchIndex, err = strconv.ParseUint(st.GetStats()["last_log_index"], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse last_log_index: %v", err)
+	}
+	if lastIndex > f.MatchIndex {
+		f.Lag = lastIndex - f.MatchIndex
+	}
+	// Parse the raw stats rather than using the typed field, so this works with followers running older versions.
+	f.LastContactNanos, err = parseLastContact(st.GetStats()["last_contact"])
+	return err
+}
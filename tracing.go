@@ -0,0 +1,109 @@
+package raftadmin
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tracerName = "github.com/Jille/raftadmin"
+
+// startSpan starts the span of a call if tracing is enabled with WithTracerProvider. If the context has no span yet, it continues the trace of the client, if it sent one.
+func (a *admin) startSpan(ctx context.Context, fullMethod, method string) (context.Context, trace.Span) {
+	if a.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	}
+	return a.tracer.Start(ctx, fullMethod[1:], trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "RaftAdmin"),
+		attribute.String("rpc.method", method),
+		attribute.String("raft.node_id", a.localID()),
+		attribute.String("raft.address", a.localAddress()),
+	))
+}
+
+// endSpan records the result of a call and ends its span.
+func endSpan(span trace.Span, err error) {
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(status.Code(err))))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+	}
+	span.End()
+}
+
+// followUpSpan starts a span for an Await or Forget of f, as a child of the span of the call that started the operation, so it shows up in the same trace.
+// The span is linked to that of the Await or Forget call itself.
+func (a *admin) followUpSpan(ctx context.Context, f *future, name string) trace.Span {
+	if a.tracer == nil || !f.spanContext.IsValid() {
+		return trace.SpanFromContext(context.Background())
+	}
+	_, span := a.tracer.Start(trace.ContextWithRemoteSpanContext(ctx, f.spanContext), name+" "+f.operation,
+		trace.WithLinks(trace.LinkFromContext(ctx)),
+		trace.WithAttributes(
+			attribute.String("raft.operation", f.operation),
+			attribute.String("raft.node_id", a.localID()),
+		))
+	return span
+}
+
+// localID returns the ID of this node in the raft configuration, or "" if it isn't in it. It remembers the result once found.
+func (a *admin) localID() string {
+	a.localIDMtx.Lock()
+	defer a.localIDMtx.Unlock()
+	if a.cachedLocalID != "" {
+		return a.cachedLocalID
+	}
+	f := a.r.GetConfiguration()
+	if f.Error() != nil {
+		return ""
+	}
+	addr := a.localAddress()
+	for _, s := range f.Configuration().Servers {
+		if string(s.Address) == addr {
+			a.cachedLocalID = string(s.ID)
+		}
+	}
+	return a.cachedLocalID
+}
+
+// tracedStream overrides the context of a stream with one that includes its span.
+type tracedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s tracedStream) Context() context.Context {
+	return s.ctx
+}
+
+// metadataCarrier lets propagators read trace context from incoming gRPC metadata.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
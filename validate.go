@@ -0,0 +1,72 @@
+package raftadmin
+
+import (
+	"net"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateRequest rejects requests that raft would accept but that make no sense, like adding a server without an ID, with INVALID_ARGUMENT.
+// Otherwise they'd end up in the configuration, where they're hard to get rid of.
+func (a *admin) validateRequest(req interface{}) error {
+	switch req := req.(type) {
+	case *pb.AddVoterRequest:
+		return a.validateServer(req.GetId(), req.GetAddress())
+	case *pb.AddNonvoterRequest:
+		return a.validateServer(req.GetId(), req.GetAddress())
+	case *pb.DemoteVoterRequest:
+		if req.GetId() == "" {
+			return status.Error(codes.InvalidArgument, "id is required")
+		}
+	case *pb.LeadershipTransferToServerRequest:
+		return a.validateServer(req.GetId(), req.GetAddress())
+	case *pb.RemoveServerRequest:
+		if req.GetId() == "" && req.GetAddress() == "" {
+			return status.Error(codes.InvalidArgument, "id or address is required")
+		}
+	case *pb.BootstrapClusterRequest:
+		return a.validateServers(req.GetServers())
+	case *pb.StageRecoveryRequest:
+		return a.validateServers(req.GetServers())
+	}
+	return nil
+}
+
+// validateServer checks that id and address are set. With WithHostPortAddresses, address must also be in the host:port form used by raft's TCP transport and raft-grpc-transport.
+func (a *admin) validateServer(id, address string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	if address == "" {
+		return status.Errorf(codes.InvalidArgument, "address of server %q is required", id)
+	}
+	if !a.hostPortAddresses {
+		return nil
+	}
+	if _, port, err := net.SplitHostPort(address); err != nil || port == "" {
+		return status.Errorf(codes.InvalidArgument, "address %q of server %q is not of the form host:port", address, id)
+	}
+	return nil
+}
+
+// validateServers checks every server of a configuration, and that no ID or address is used twice.
+func (a *admin) validateServers(servers []*pb.GetConfigurationResponse_Server) error {
+	ids := map[string]bool{}
+	addresses := map[string]bool{}
+	for _, s := range servers {
+		if err := a.validateServer(s.GetId(), s.GetAddress()); err != nil {
+			return err
+		}
+		if ids[s.GetId()] {
+			return status.Errorf(codes.InvalidArgument, "server %q is listed twice", s.GetId())
+		}
+		if addresses[s.GetAddress()] {
+			return status.Errorf(codes.InvalidArgument, "address %q is used by more than one server", s.GetAddress())
+		}
+		ids[s.GetId()] = true
+		addresses[s.GetAddress()] = true
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package raftadmin
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidateServerAddresses(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	leader := bootstrap(t, nodes[0])
+	ctx := context.Background()
+
+	c := newTestClient(t, newTestServer(t, leader.r))
+	_, err := c.AddNonvoter(ctx, &pb.AddNonvoterRequest{Address: string(nodes[1].addr)})
+	wantCode(t, err, codes.InvalidArgument, "id is required")
+	_, err = c.AddNonvoter(ctx, &pb.AddNonvoterRequest{Id: string(nodes[1].id)})
+	wantCode(t, err, codes.InvalidArgument, "address of server")
+	// InmemTransport addresses are UUIDs, not host:port, and raft takes them just fine.
+	f, err := c.AddNonvoter(ctx, &pb.AddNonvoterRequest{Id: string(nodes[1].id), Address: string(nodes[1].addr)})
+	if err != nil {
+		t.Fatalf("AddNonvoter with an InmemTransport address failed: %v", err)
+	}
+	if resp, err := c.AwaitAndForget(ctx, f.Future()); err != nil || resp.GetError() != "" {
+		t.Fatalf("AwaitAndForget failed: %v %s", err, resp.GetError())
+	}
+
+	strict := newTestClient(t, newTestServer(t, leader.r, WithHostPortAddresses()))
+	_, err = strict.AddNonvoter(ctx, &pb.AddNonvoterRequest{Id: string(nodes[2].id), Address: string(nodes[2].addr)})
+	wantCode(t, err, codes.InvalidArgument, "not of the form host:port")
+	_, err = strict.BootstrapCluster(ctx, &pb.BootstrapClusterRequest{Servers: []*pb.GetConfigurationResponse_Server{
+		{Id: "a", Address: "10.0.0.1:8300"},
+		{Id: "b", Address: "10.0.0.1:8300"},
+	}})
+	wantCode(t, err, codes.InvalidArgument, "used by more than one server")
+	if hasServer(t, leader.r, nodes[2].id) {
+		t.Errorf("%s was added despite its address", nodes[2].id)
+	}
+}
@@ -0,0 +1,125 @@
+package raftadmin
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Jille/raftadmin/proto"
+	"github.com/hashicorp/raft"
+	"google.golang.org/protobuf/proto"
+)
+
+// watch calls check right away, after every observation that passes filter and, if interval isn't 0, periodically to catch changes raft doesn't report.
+// check gets the observation that triggered it, or nil. Observations can be dropped if check is slow, so check should read the current value if it doesn't need every intermediate one.
+// It returns once check returns done or an error, or ctx is cancelled.
+func (a *admin) watch(ctx context.Context, filter raft.FilterFn, interval time.Duration, check func(o *raft.Observation) (done bool, err error)) error {
+	ch := make(chan raft.Observation, 16)
+	if filter != nil {
+		o := raft.NewObserver(ch, false, filter)
+		// Register before the first check, so no change is missed in between.
+		a.r.RegisterObserver(o)
+		defer a.r.DeregisterObserver(o)
+	}
+	var tick <-chan time.Time
+	if interval > 0 {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	var o *raft.Observation
+	for {
+		if done, err := check(o); done || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case obs := <-ch:
+			o = &obs
+		case <-tick:
+			o = nil
+		}
+	}
+}
+
+func (a *admin) WatchAppliedIndex(req *pb.WatchAppliedIndexRequest, stream pb.RaftAdmin_WatchAppliedIndexServer) error {
+	interval := time.Duration(req.GetMinIntervalMs()) * time.Millisecond
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+	var last *pb.AppliedIndexResponse
+	// raft doesn't report applied entries to observers, so poll.
+	return a.watch(stream.Context(), nil, interval, func(o *raft.Observation) (bool, error) {
+		applied := a.r.AppliedIndex()
+		if last != nil && last.GetIndex() == applied {
+			return false, nil
+		}
+		last = &pb.AppliedIndexResponse{Index: applied}
+		return false, stream.Send(last)
+	})
+}
+
+func (a *admin) WatchConfiguration(req *pb.WatchConfigurationRequest, stream pb.RaftAdmin_WatchConfigurationServer) error {
+	var last *pb.GetConfigurationResponse
+	// raft only reports configuration changes on the leader, so followers notice them by polling.
+	return a.watch(stream.Context(), func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.PeerObservation)
+		return ok
+	}, time.Second, func(o *raft.Observation) (bool, error) {
+		c, err := a.configuration()
+		if err != nil {
+			return false, err
+		}
+		if last != nil && proto.Equal(last, c) {
+			return false, nil
+		}
+		last = c
+		return false, stream.Send(last)
+	})
+}
+
+func (a *admin) WatchLeader(req *pb.WatchLeaderRequest, stream pb.RaftAdmin_WatchLeaderServer) error {
+	var last *pb.LeaderResponse
+	return a.watch(stream.Context(), func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.LeaderObservation)
+		return ok
+	}, 0, func(o *raft.Observation) (bool, error) {
+		addr, id := a.r.LeaderWithID()
+		if o != nil {
+			lo := o.Data.(raft.LeaderObservation)
+			addr, id = lo.LeaderAddr, lo.LeaderID
+		}
+		if last != nil && last.GetAddress() == string(addr) && last.GetId() == string(id) {
+			return false, nil
+		}
+		last = &pb.LeaderResponse{
+			Address: string(addr),
+			Id:      string(id),
+		}
+		return false, stream.Send(last)
+	})
+}
+
+func (a *admin) WatchState(req *pb.WatchStateRequest, stream pb.RaftAdmin_WatchStateServer) error {
+	var last *pb.StateResponse
+	return a.watch(stream.Context(), func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.RaftState)
+		return ok
+	}, 0, func(o *raft.Observation) (bool, error) {
+		// Use the observed state rather than the current one, so short-lived states like CANDIDATE are reported too.
+		rs := a.r.State()
+		if o != nil {
+			rs = o.Data.(raft.RaftState)
+		}
+		s, err := toState(rs)
+		if err != nil {
+			return false, err
+		}
+		if last != nil && last.GetState() == s {
+			return false, nil
+		}
+		last = &pb.StateResponse{State: s}
+		// A node that was shut down never changes state again.
+		return s == pb.StateResponse_SHUTDOWN, stream.Send(last)
+	})
+}